@@ -0,0 +1,255 @@
+package golinal
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// Binary format, version 1. A 24 byte header
+//
+//	offset  size  field
+//	0       4     magic "GLMX"
+//	4       1     version
+//	5       1     byte order of what follows, 0 little, 1 big endian
+//	6       1     dtype, 1 for float64
+//	7       1     reserved, 0
+//	8       8     rows, uint64
+//	16      8     cols, uint64
+//
+// followed by the rows*cols entries in row-major order.
+// Matrices are always written little endian, but either
+// order is read.
+const (
+	binaryMagic      = "GLMX"
+	binaryVersion    = 1
+	binaryHeaderSize = 24
+
+	binaryLittleEndian = 0
+	binaryBigEndian    = 1
+
+	binaryFloat64 = 1
+
+	// Entries staged per Write or Read when streaming
+	binaryChunk = 4096
+)
+
+// MaxDecodeEntries caps the rows*cols that ReadFrom() and
+// ReadMatrixMarket() accept from a header, before the entries
+// themselves have arrived, so a hostile header can't exhaust
+// memory. Raise it to read larger matrices.
+var MaxDecodeEntries = 1 << 28
+
+// brief: Encodes a Matrix in the versioned binary format
+//
+// details: Implements encoding.BinaryMarshaler, which also
+//          makes *Matrix work with encoding/gob
+//
+// returns: the encoding, the error is always nil
+func (m *Matrix) MarshalBinary() ([]byte, error) {
+	data := make([]byte, binaryHeaderSize+8*m.numRows*m.numCols)
+	m.putBinaryHeader(data)
+
+	body := data[binaryHeaderSize:]
+	for i := 0; i < m.numRows; i++ {
+		for _, v := range m.rowView(i) {
+			binary.LittleEndian.PutUint64(body, math.Float64bits(v))
+			body = body[8:]
+		}
+	}
+
+	return data, nil
+}
+
+// brief: Decodes a Matrix written by MarshalBinary() or WriteTo()
+//
+// details: Implements encoding.BinaryUnmarshaler. m is
+//          replaced, data isn't retained.
+//
+// returns: an error if the header is invalid or data
+//          has the wrong length
+func (m *Matrix) UnmarshalBinary(data []byte) error {
+	if len(data) < binaryHeaderSize {
+		return io.ErrUnexpectedEOF
+	}
+	rows, cols, order, err := parseBinaryHeader(data)
+	if err != nil {
+		return err
+	}
+	if want := binaryHeaderSize + 8*rows*cols; len(data) != want {
+		return fmt.Errorf("Binary Matrix is %d bytes, expected %d for %dx%d", len(data), want, rows, cols)
+	}
+
+	*m = *BlankMatrix(rows, cols)
+	body := data[binaryHeaderSize:]
+	for k := range m.data {
+		m.data[k] = math.Float64frombits(order.Uint64(body[8*k:]))
+	}
+
+	return nil
+}
+
+// brief: Streams a Matrix to w in the binary format
+//
+// details: Implements io.WriterTo. Entries go through a
+//          fixed 32 KiB buffer, so a large Matrix is never
+//          copied whole.
+//
+// returns: the number of bytes written and any error from w
+func (m *Matrix) WriteTo(w io.Writer) (int64, error) {
+	header := make([]byte, binaryHeaderSize)
+	m.putBinaryHeader(header)
+	n, err := w.Write(header)
+	written := int64(n)
+	if err != nil {
+		return written, err
+	}
+
+	buf := make([]byte, 0, 8*binaryChunk)
+	flush := func() error {
+		n, err := w.Write(buf)
+		written += int64(n)
+		buf = buf[:0]
+		return err
+	}
+	for i := 0; i < m.numRows; i++ {
+		for _, v := range m.rowView(i) {
+			buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(v))
+			if len(buf) == cap(buf) {
+				if err := flush(); err != nil {
+					return written, err
+				}
+			}
+		}
+	}
+	if len(buf) > 0 {
+		if err := flush(); err != nil {
+			return written, err
+		}
+	}
+
+	return written, nil
+}
+
+// brief: Streams a Matrix from r in the binary format
+//
+// details: Implements io.ReaderFrom. Reads exactly one
+//          Matrix through a fixed 32 KiB buffer and replaces
+//          m with it. Storage grows as entries arrive, so a
+//          truncated stream never allocates what its header
+//          claims.
+//
+// returns: the number of bytes read, and an error if the
+//          header is invalid, claims more than MaxDecodeEntries
+//          entries, or r ends early, in which case m is
+//          unchanged. io.EOF means r had nothing left, so
+//          matrices can be read back to back.
+func (m *Matrix) ReadFrom(r io.Reader) (int64, error) {
+	header := make([]byte, binaryHeaderSize)
+	n, err := io.ReadFull(r, header)
+	read := int64(n)
+	if err != nil {
+		return read, err
+	}
+	rows, cols, order, err := parseBinaryHeader(header)
+	if err != nil {
+		return read, err
+	}
+
+	if err := checkDecodeSize(rows, cols); err != nil {
+		return read, err
+	}
+
+	total := rows * cols
+	data := make([]float64, 0, min(total, binaryChunk))
+	buf := make([]byte, 8*binaryChunk)
+	for len(data) < total {
+		chunk := min(binaryChunk, total-len(data))
+		n, err := io.ReadFull(r, buf[:8*chunk])
+		read += int64(n)
+		if err != nil {
+			return read, unexpectedEOF(err)
+		}
+		for c := 0; c < chunk; c++ {
+			data = append(data, math.Float64frombits(order.Uint64(buf[8*c:])))
+		}
+	}
+	*m = Matrix{numRows: rows, numCols: cols, stride: cols, data: data}
+
+	return read, nil
+}
+
+///////////////////////////////
+//         HELPER            //
+//         FUNCTIONS         //
+///////////////////////////////
+
+// brief: Fills the first binaryHeaderSize bytes of dst
+func (m *Matrix) putBinaryHeader(dst []byte) {
+	copy(dst, binaryMagic)
+	dst[4] = binaryVersion
+	dst[5] = binaryLittleEndian
+	dst[6] = binaryFloat64
+	dst[7] = 0
+	binary.LittleEndian.PutUint64(dst[8:], uint64(m.numRows))
+	binary.LittleEndian.PutUint64(dst[16:], uint64(m.numCols))
+}
+
+// brief: Validates a binary header
+//
+// returns: the dimensions and byte order it describes, or
+//          an error naming what's wrong with it
+func parseBinaryHeader(header []byte) (int, int, binary.ByteOrder, error) {
+	if string(header[:4]) != binaryMagic {
+		return 0, 0, nil, errors.New("Not a binary Matrix: bad magic")
+	}
+	if header[4] != binaryVersion {
+		return 0, 0, nil, fmt.Errorf("Unsupported binary Matrix version %d", header[4])
+	}
+
+	var order binary.ByteOrder
+	switch header[5] {
+	case binaryLittleEndian:
+		order = binary.LittleEndian
+	case binaryBigEndian:
+		order = binary.BigEndian
+	default:
+		return 0, 0, nil, fmt.Errorf("Unknown binary Matrix byte order %d", header[5])
+	}
+	if header[6] != binaryFloat64 {
+		return 0, 0, nil, fmt.Errorf("Unsupported binary Matrix dtype %d", header[6])
+	}
+
+	// Reject sizes that would overflow before allocating
+	rows, cols := order.Uint64(header[8:]), order.Uint64(header[16:])
+	limit := uint64(math.MaxInt / 8)
+	if rows > limit || cols > limit || (cols != 0 && rows > limit/cols) {
+		return 0, 0, nil, fmt.Errorf("Binary Matrix of %dx%d is too large", rows, cols)
+	}
+
+	return int(rows), int(cols), order, nil
+}
+
+// brief: Checks the shape in a header against MaxDecodeEntries
+//
+// returns: nil, or an error if rows*cols is too large,
+//          which includes overflowing an int
+func checkDecodeSize(rows, cols int) error {
+	if rows < 0 || cols < 0 || (cols != 0 && rows > MaxDecodeEntries/cols) {
+		return fmt.Errorf("Matrix of %dx%d is larger than MaxDecodeEntries", rows, cols)
+	}
+
+	return nil
+}
+
+// brief: Turns a clean io.EOF in the middle of a Matrix
+//        into io.ErrUnexpectedEOF
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
@@ -0,0 +1,164 @@
+package golinal
+
+import (
+    "github.com/stretchr/testify/suite";
+    "bytes";
+    "encoding/binary";
+    "encoding/gob";
+    "io";
+    "math";
+    "testing"
+)
+
+//*******************************
+// Binary Serialization Test Suite
+//*******************************
+
+type BinaryTestSuite struct {
+    suite.Suite
+
+    Matrices []*Matrix
+}
+
+func (suite *BinaryTestSuite) SetupTest() {
+    special := MustMatrix(
+        []float64{math.NaN(), math.Inf(1)},
+        []float64{math.Inf(-1), math.Copysign(0, -1)})
+
+    // Large enough to span several streaming chunks
+    suite.Matrices = []*Matrix{RandMatrix, NonsquareMatrix, BlankMatrix(0, 3), special, randomMatrix(150, 70, 3)}
+}
+
+// NaN != NaN, so compare bit patterns
+func (suite *BinaryTestSuite) sameBits(expected, actual *Matrix) {
+    suite.Equal(expected.numRows, actual.numRows, "They should be equal")
+    suite.Equal(expected.numCols, actual.numCols, "They should be equal")
+    for i := 0; i < expected.numRows; i++ {
+        for j := 0; j < expected.numCols; j++ {
+            suite.Equal(math.Float64bits(expected.At(i, j)), math.Float64bits(actual.At(i, j)), "They should be equal")
+        }
+    }
+}
+
+func (suite *BinaryTestSuite) TestMarshal() {
+    for _, m := range suite.Matrices {
+        data, err := m.MarshalBinary()
+        suite.Equal(nil, err, "There should be no error")
+        suite.Equal(24+8*m.numRows*m.numCols, len(data), "They should be equal")
+
+        read := new(Matrix)
+        suite.Equal(nil, read.UnmarshalBinary(data), "There should be no error")
+        suite.sameBits(m, read)
+    }
+}
+
+// Streaming writes the same bytes as MarshalBinary,
+// and several matrices can share one stream
+func (suite *BinaryTestSuite) TestStream() {
+    var buf bytes.Buffer
+    for _, m := range suite.Matrices {
+        n, err := m.WriteTo(&buf)
+        suite.Equal(nil, err, "There should be no error")
+
+        data, _ := m.MarshalBinary()
+        suite.Equal(int64(len(data)), n, "They should be equal")
+        suite.Equal(data, buf.Bytes()[buf.Len()-len(data):], "They should be equal")
+    }
+
+    for _, m := range suite.Matrices {
+        read := new(Matrix)
+        _, err := read.ReadFrom(&buf)
+        suite.Equal(nil, err, "There should be no error")
+        suite.sameBits(m, read)
+    }
+
+    _, err := new(Matrix).ReadFrom(&buf)
+    suite.Equal(io.EOF, err, "They should be equal")
+}
+
+func (suite *BinaryTestSuite) TestGob() {
+    type cached struct {
+        Name string
+        A    *Matrix
+    }
+
+    var buf bytes.Buffer
+    suite.Equal(nil, gob.NewEncoder(&buf).Encode(cached{"rand", RandMatrix}), "There should be no error")
+
+    var decoded cached
+    suite.Equal(nil, gob.NewDecoder(&buf).Decode(&decoded), "There should be no error")
+    suite.Equal("rand", decoded.Name, "They should be equal")
+    suite.Equal(RandMatrix, decoded.A, "They should be equal")
+}
+
+// Files written on a big endian machine read the same
+func (suite *BinaryTestSuite) TestBigEndian() {
+    data := append([]byte("GLMX"), 1, 1, 1, 0)
+    data = binary.BigEndian.AppendUint64(data, 1)
+    data = binary.BigEndian.AppendUint64(data, 2)
+    data = binary.BigEndian.AppendUint64(data, math.Float64bits(1.5))
+    data = binary.BigEndian.AppendUint64(data, math.Float64bits(-2))
+
+    read := new(Matrix)
+    suite.Equal(nil, read.UnmarshalBinary(data), "There should be no error")
+    suite.Equal(MustMatrix([]float64{1.5, -2}), read, "They should be equal")
+}
+
+func (suite *BinaryTestSuite) TestInvalid() {
+    good, _ := RandFourMatrix.MarshalBinary()
+    corrupt := func(offset int, b byte) []byte {
+        data := append([]byte(nil), good...)
+        data[offset] = b
+        return data
+    }
+
+    for _, data := range [][]byte{
+        good[:10],
+        good[:len(good)-1],
+        corrupt(0, 'X'),
+        corrupt(4, 2),
+        corrupt(5, 7),
+        corrupt(6, 2),
+        corrupt(15, 0x7f),
+    } {
+        m := RandMatrix.copy()
+        suite.NotEqual(nil, m.UnmarshalBinary(data), "There should be an error")
+
+        _, err := m.ReadFrom(bytes.NewReader(data))
+        suite.NotEqual(nil, err, "There should be an error")
+        suite.Equal(RandMatrix, m, "Matrix should be unchanged")
+    }
+
+    _, err := new(Matrix).ReadFrom(bytes.NewReader(good[:40]))
+    suite.Equal(io.ErrUnexpectedEOF, err, "They should be equal")
+}
+
+// A bare header must not allocate what it claims
+func (suite *BinaryTestSuite) TestHugeHeader() {
+    header := func(rows, cols uint64) []byte {
+        data := append([]byte("GLMX"), 1, 0, 1, 0)
+        data = binary.LittleEndian.AppendUint64(data, rows)
+        return binary.LittleEndian.AppendUint64(data, cols)
+    }
+
+    m := RandMatrix.copy()
+    _, err := m.ReadFrom(bytes.NewReader(header(1<<28, 1<<30)))
+    suite.NotEqual(nil, err, "There should be an error")
+    suite.Equal(RandMatrix, m, "Matrix should be unchanged")
+
+    // Within MaxDecodeEntries, but the entries never come
+    _, err = m.ReadFrom(bytes.NewReader(header(1<<14, 1<<14)))
+    suite.Equal(io.ErrUnexpectedEOF, err, "They should be equal")
+    suite.Equal(RandMatrix, m, "Matrix should be unchanged")
+
+    defer func(max int) { MaxDecodeEntries = max }(MaxDecodeEntries)
+    MaxDecodeEntries = 10
+    var buf bytes.Buffer
+    RandFourMatrix.WriteTo(&buf)
+    _, err = m.ReadFrom(&buf)
+    suite.NotEqual(nil, err, "The cap should be configurable")
+}
+
+func TestBinary(t *testing.T) {
+    suite.Run(t, new(BinaryTestSuite))
+}
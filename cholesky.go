@@ -0,0 +1,219 @@
+package golinal
+
+import (
+	"math"
+)
+
+// Cholesky Struct Definition
+//
+// details: Holds the factorization A = LL^T of a symmetric
+//          positive-definite Matrix A, with L lower triangular
+//          and a positive diagonal. The factor can be updated
+//          in O(n^2) as rows are added to or removed from the
+//          data A was built from.
+type Cholesky struct {
+	l *Matrix
+}
+
+// brief: Calculates the Cholesky factorization of a Matrix
+//
+// details: Row by row (Cholesky-Banachiewicz), O(n^3/3).
+//          Only the lower triangle of m is read, m is
+//          assumed to be symmetric.
+//
+// returns: the factorization of m, ErrNotSquare, or
+//          a *ErrNotPositiveDefinite
+func (m *Matrix) Cholesky() (*Cholesky, error) {
+	if !m.IsSquare() {
+		return nil, ErrNotSquare
+	}
+
+	n := m.numRows
+	L := BlankMatrix(n, n)
+
+	// l_{ij} = \frac{1}{l_{jj}} (a_{ij} - \sum_{k<j} l_{ik} l_{jk})
+	// l_{ii} = \sqrt{a_{ii} - \sum_{k<i} l_{ik}^2}
+	for i := 0; i < n; i++ {
+		rowI := L.rowView(i)
+		for j := 0; j < i; j++ {
+			rowJ := L.rowView(j)
+			rowI[j] = (m.At(i, j) - dot(rowI[:j], rowJ[:j])) / rowJ[j]
+		}
+
+		d := m.At(i, i) - dot(rowI[:i], rowI[:i])
+		if !(d > 0) {
+			return nil, &ErrNotPositiveDefinite{Index: i, Pivot: d}
+		}
+		rowI[i] = math.Sqrt(d)
+	}
+
+	return &Cholesky{l: L}, nil
+}
+
+// brief: Gets the lower triangular factor
+//
+// returns: L as a new Matrix
+func (c *Cholesky) L() *Matrix {
+	return c.l.copy()
+}
+
+// brief: Solves Ax = b using the factorization
+//
+// details: forward substitution with L followed by
+//          backward substitution with L^T, O(n^2)
+//
+// returns: x, or an error if b has the wrong length
+func (c *Cholesky) Solve(b []float64) ([]float64, error) {
+	n := c.l.numRows
+	if len(b) != n {
+		return nil, errShape("solve", n, n, len(b), 1)
+	}
+
+	x := make([]float64, n)
+	copy(x, b)
+	c.solveInPlace(x)
+
+	return x, nil
+}
+
+// brief: Calculates the determinant of the factorized Matrix
+//
+// details: det(A) = (l_11 * ... * l_nn)^2, O(n)
+//
+// returns: the determinant
+func (c *Cholesky) Det() float64 {
+	det := 1.0
+	for i := 0; i < c.l.numRows; i++ {
+		det *= c.l.At(i, i)
+	}
+
+	return det * det
+}
+
+// brief: Calculates the log of the determinant
+//
+// details: Useful when Det() would overflow or underflow,
+//          which is common for large covariance matrices
+//
+// returns: log(det(A)), the determinant being positive
+func (c *Cholesky) LogDet() float64 {
+	logDet := 0.0
+	for i := 0; i < c.l.numRows; i++ {
+		logDet += math.Log(c.l.At(i, i))
+	}
+
+	return 2 * logDet
+}
+
+// brief: Calculates the inverse of the factorized Matrix
+//
+// details: Solves AX = I column by column, O(n^3)
+//
+// returns: the inverse of A
+func (c *Cholesky) Inverse() *Matrix {
+	n := c.l.numRows
+	inv := BlankMatrix(n, n)
+	col := make([]float64, n)
+	for j := 0; j < n; j++ {
+		for i := range col {
+			col[i] = 0
+		}
+		col[j] = 1
+		c.solveInPlace(col)
+		for i := 0; i < n; i++ {
+			inv.data[i*inv.stride+j] = col[i]
+		}
+	}
+
+	return inv
+}
+
+// brief: Updates the factorization to that of A + xx^T
+//
+// details: A sequence of Givens rotations applied to the
+//          columns of L, O(n^2). A + xx^T is always positive
+//          definite so this can't fail for the right length.
+//
+// returns: an error if x has the wrong length
+func (c *Cholesky) Update(x []float64) error {
+	n := c.l.numRows
+	if len(x) != n {
+		return errShape("update", n, n, len(x), 1)
+	}
+
+	w := make([]float64, n)
+	copy(w, x)
+	for k := 0; k < n; k++ {
+		lkk := c.l.At(k, k)
+		r := math.Hypot(lkk, w[k])
+		cs, sn := r/lkk, w[k]/lkk
+		c.l.data[k*c.l.stride+k] = r
+
+		for i := k + 1; i < n; i++ {
+			lik := &c.l.data[i*c.l.stride+k]
+			*lik = (*lik + sn*w[i]) / cs
+			w[i] = cs*w[i] - sn*(*lik)
+		}
+	}
+
+	return nil
+}
+
+// brief: Updates the factorization to that of A - xx^T
+//
+// details: Hyperbolic rotations applied to the columns
+//          of L, O(n^2). The factorization is left
+//          untouched if the downdate fails.
+//
+// returns: an error if x has the wrong length, or a
+//          *ErrNotPositiveDefinite if A - xx^T isn't
+//          positive definite
+func (c *Cholesky) Downdate(x []float64) error {
+	n := c.l.numRows
+	if len(x) != n {
+		return errShape("downdate", n, n, len(x), 1)
+	}
+
+	L := c.l.copy()
+	w := make([]float64, n)
+	copy(w, x)
+	for k := 0; k < n; k++ {
+		lkk := L.At(k, k)
+		d := (lkk - w[k]) * (lkk + w[k])
+		if !(d > 0) {
+			return &ErrNotPositiveDefinite{Index: k, Pivot: d}
+		}
+		r := math.Sqrt(d)
+		cs, sn := r/lkk, w[k]/lkk
+		L.data[k*L.stride+k] = r
+
+		for i := k + 1; i < n; i++ {
+			lik := &L.data[i*L.stride+k]
+			*lik = (*lik - sn*w[i]) / cs
+			w[i] = cs*w[i] - sn*(*lik)
+		}
+	}
+	c.l = L
+
+	return nil
+}
+
+// brief: Overwrites x with the solution of LL^Tx = x
+func (c *Cholesky) solveInPlace(x []float64) {
+	n := c.l.numRows
+
+	// Solve Ly = b
+	for i := 0; i < n; i++ {
+		row := c.l.rowView(i)
+		x[i] = (x[i] - dot(row[:i], x)) / row[i]
+	}
+
+	// Solve L^Tx = y
+	// Row i of L is column i of L^T, so once x_i is
+	// known it is eliminated from the earlier entries
+	for i := n - 1; i >= 0; i-- {
+		row := c.l.rowView(i)
+		x[i] /= row[i]
+		axpy(-x[i], row[:i], x[:i])
+	}
+}
@@ -0,0 +1,113 @@
+package golinal
+
+import (
+    "github.com/stretchr/testify/suite";
+    "errors";
+    "math";
+    "testing"
+)
+
+//*************************************
+// Cholesky Decomposition Test Suite
+//*************************************
+
+type CholeskyTestSuite struct {
+    suite.Suite
+
+    SPDMatrix,
+    LSPD *Matrix
+
+    Indefinite *Matrix
+}
+
+func (suite *CholeskyTestSuite) SetupTest() {
+    suite.SPDMatrix = MustMatrix(
+        []float64{  4,  12, -16},
+        []float64{ 12,  37, -43},
+        []float64{-16, -43,  98})
+
+    suite.LSPD = MustMatrix(
+        []float64{ 2, 0, 0},
+        []float64{ 6, 1, 0},
+        []float64{-8, 5, 3})
+
+    suite.Indefinite = MustMatrix(
+        []float64{1, 2},
+        []float64{2, 1})
+}
+
+func (suite *CholeskyTestSuite) TestFactorize() {
+    chol, err := suite.SPDMatrix.Cholesky()
+
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal(suite.LSPD, chol.L(), "They should be equal")
+    suite.InDelta(36.0, chol.Det(), 1e-9)
+    suite.InDelta(math.Log(36), chol.LogDet(), 1e-12)
+
+    _, err = NonsquareMatrix.Cholesky()
+    suite.NotEqual(nil, err, "There should be an error")
+}
+
+func (suite *CholeskyTestSuite) TestNotPositiveDefinite() {
+    chol, err := suite.Indefinite.Cholesky()
+
+    var npd *ErrNotPositiveDefinite
+    suite.Nil(chol, "Indefinite Matrix has no Cholesky factorization")
+    suite.True(errors.As(err, &npd), "Error should match ErrNotPositiveDefinite")
+    suite.True(errors.Is(err, &ErrNotPositiveDefinite{}), "Error should match ErrNotPositiveDefinite")
+    suite.Equal(1, npd.Index, "They should be equal")
+    suite.Equal(-3.0, npd.Pivot, "They should be equal")
+}
+
+func (suite *CholeskyTestSuite) TestSolveInverse() {
+    chol, _ := suite.SPDMatrix.Cholesky()
+
+    x, err := chol.Solve([]float64{0, 6, 39})
+    suite.Equal(nil, err, "There should be no error")
+    suite.InDeltaSlice([]float64{1, 1, 1}, x, 1e-9)
+
+    _, err = chol.Solve([]float64{1})
+    suite.NotEqual(nil, err, "There should be an error")
+
+    product, _ := suite.SPDMatrix.Multiply(chol.Inverse())
+    matrixInDelta(&suite.Suite, Identity(3), product, 1e-9)
+}
+
+// Updating then downdating by the same vector
+// gives back the original factor
+func (suite *CholeskyTestSuite) TestUpdateDowndate() {
+    chol, _ := suite.SPDMatrix.Cholesky()
+    x := []float64{1, -2, 3}
+
+    suite.Equal(nil, chol.Update(x), "There should be no error")
+
+    updated := suite.SPDMatrix.copy()
+    for i := 0; i < 3; i++ {
+        for j := 0; j < 3; j++ {
+            updated.data[i*updated.stride+j] += x[i] * x[j]
+        }
+    }
+    expected, _ := updated.Cholesky()
+    matrixInDelta(&suite.Suite, expected.L(), chol.L(), 1e-12)
+
+    suite.Equal(nil, chol.Downdate(x), "There should be no error")
+    matrixInDelta(&suite.Suite, suite.LSPD, chol.L(), 1e-12)
+
+    suite.NotEqual(nil, chol.Update([]float64{1}), "There should be an error")
+}
+
+// A failed downdate reports the offending column
+// and leaves the factor unchanged
+func (suite *CholeskyTestSuite) TestDowndateFails() {
+    chol, _ := suite.SPDMatrix.Cholesky()
+
+    var npd *ErrNotPositiveDefinite
+    err := chol.Downdate([]float64{3, 0, 0})
+    suite.True(errors.As(err, &npd), "Error should match ErrNotPositiveDefinite")
+    suite.Equal(0, npd.Index, "They should be equal")
+    suite.Equal(suite.LSPD, chol.L(), "Factor should be unchanged")
+}
+
+func TestCholesky(t *testing.T) {
+    suite.Run(t, new(CholeskyTestSuite))
+}
@@ -0,0 +1,247 @@
+package golinal
+
+import (
+	"fmt"
+	"math/cmplx"
+)
+
+// CMatrix Struct Definition
+//
+// details: A Matrix of complex128 entries, stored the same
+//          way: row-major with entry (i, j) at data[i*stride + j].
+//          Build one from real and imaginary parts with
+//          NewCMatrixFromParts(), e.g. the eigenvectors of
+//          Eigen.Vectors(), and get them back with Real() and
+//          Imag(). It embeds a Dense[complex128], which supplies
+//          Dims(), At(), Set() and RawData(), and solves go
+//          through DenseLUP().
+type CMatrix struct {
+	Dense[complex128]
+}
+
+// brief: Parameterized constructor that takes slice
+//        of slices of complex numbers
+//
+// details: The entries are copied, as in NewMatrix()
+//
+// returns: a pointer to a CMatrix, or an error if the
+//          rows don't all have the same length
+func NewCMatrix(slices ...[]complex128) (*CMatrix, error) {
+	if len(slices) == 0 {
+		return BlankCMatrix(0, 0), nil
+	}
+
+	cols := len(slices[0])
+	for i, row := range slices {
+		if len(row) != cols {
+			return nil, fmt.Errorf("Row %d has %d columns, expected %d", i, len(row), cols)
+		}
+	}
+
+	c := BlankCMatrix(len(slices), cols)
+	for i, row := range slices {
+		copy(c.rowView(i), row)
+	}
+
+	return c, nil
+}
+
+// brief: Builds a CMatrix from its real and imaginary parts
+//
+// inputs: im may be nil for a CMatrix with real entries
+//
+// returns: a pointer to re + i*im, or an *ErrShape if the
+//          parts have different dimensions
+func NewCMatrixFromParts(re, im *Matrix) (*CMatrix, error) {
+	if im != nil && (re.numRows != im.numRows || re.numCols != im.numCols) {
+		return nil, errShape("complex parts", re.numRows, re.numCols, im.numRows, im.numCols)
+	}
+
+	c := BlankCMatrix(re.numRows, re.numCols)
+	for i := 0; i < c.numRows; i++ {
+		row := c.rowView(i)
+		for j, x := range re.rowView(i) {
+			row[j] = complex(x, 0)
+		}
+		if im != nil {
+			for j, y := range im.rowView(i) {
+				row[j] += complex(0, y)
+			}
+		}
+	}
+
+	return c, nil
+}
+
+// brief: Constructor for a zero CMatrix
+//
+// returns: a pointer to a rows x cols CMatrix
+func BlankCMatrix(rows, cols int) *CMatrix {
+	return &CMatrix{*BlankDense[complex128](rows, cols)}
+}
+
+// brief: Gets the real part of every entry
+//
+// returns: a new Matrix
+func (c *CMatrix) Real() *Matrix {
+	m := BlankMatrix(c.numRows, c.numCols)
+	for i := 0; i < c.numRows; i++ {
+		row := m.rowView(i)
+		for j, z := range c.rowView(i) {
+			row[j] = real(z)
+		}
+	}
+
+	return m
+}
+
+// brief: Gets the imaginary part of every entry
+//
+// returns: a new Matrix
+func (c *CMatrix) Imag() *Matrix {
+	m := BlankMatrix(c.numRows, c.numCols)
+	for i := 0; i < c.numRows; i++ {
+		row := m.rowView(i)
+		for j, z := range c.rowView(i) {
+			row[j] = imag(z)
+		}
+	}
+
+	return m
+}
+
+// brief: Views a CMatrix as a Dense[complex128]
+//
+// returns: a pointer to a Dense sharing storage with c
+func (c *CMatrix) AsDense() *Dense[complex128] {
+	return &c.Dense
+}
+
+// brief: Adds q to c in place
+//
+// returns: an *ErrShape if the dimensions differ
+func (c *CMatrix) Add(q *CMatrix) error {
+	return c.Dense.Add(&q.Dense)
+}
+
+// brief: Multiplys the CMatrix c by the CMatrix q
+//
+// returns: the product, or an *ErrShape if c has a
+//          different number of columns than q has rows
+func (c *CMatrix) Multiply(q *CMatrix) (*CMatrix, error) {
+	d, err := c.Dense.Multiply(&q.Dense)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CMatrix{*d}, nil
+}
+
+// brief: Calculates the transpose of a CMatrix
+//
+// note: the entries aren't conjugated, see ConjTranspose()
+//
+// returns: c^T as a new CMatrix
+func (c *CMatrix) Transpose() *CMatrix {
+	return &CMatrix{*c.Dense.Transpose()}
+}
+
+// brief: Calculates the conjugate transpose of a CMatrix
+//
+// details: Entry (i, j) of the result is conj(c_ji)
+//
+// returns: c^H as a new CMatrix
+func (c *CMatrix) ConjTranspose() *CMatrix {
+	h := BlankCMatrix(c.numCols, c.numRows)
+	for i := 0; i < c.numRows; i++ {
+		for j, z := range c.rowView(i) {
+			h.data[j*h.stride+i] = cmplx.Conj(z)
+		}
+	}
+
+	return h
+}
+
+// brief: Calculates the conjugate transpose of a CMatrix
+//
+// details: Shorthand for ConjTranspose()
+//
+// returns: c^H as a new CMatrix
+func (c *CMatrix) H() *CMatrix {
+	return c.ConjTranspose()
+}
+
+// brief: Calculates the LUP decomposition of a CMatrix
+//
+// details: Partial pivoting on the modulus of each entry,
+//          see DenseLUP(). The receiver is not modified.
+//
+// returns: the factorization of c, or ErrNotSquare
+func (c *CMatrix) LUP() (*DenseLU[complex128], error) {
+	return DenseLUP(c.AsDense())
+}
+
+// brief: Solves Ax = b for a square CMatrix A
+//
+// details: Factorize once with LUP() to solve for many
+//          right hand sides
+//
+// returns: x, or an error if A isn't square, is singular
+//          or b has the wrong length
+func (c *CMatrix) Solve(b []complex128) ([]complex128, error) {
+	lu, err := c.LUP()
+	if err != nil {
+		return nil, err
+	}
+
+	return lu.Solve(b)
+}
+
+// brief: Reports whether c equals its conjugate transpose
+//
+// inputs: tol, the largest |c_ij - conj(c_ji)| allowed
+//
+// returns: true if c is square and Hermitian within tol
+func (c *CMatrix) IsHermitian(tol float64) bool {
+	if c.numRows != c.numCols {
+		return false
+	}
+	for i := 0; i < c.numRows; i++ {
+		for j := i; j < c.numCols; j++ {
+			if !(cmplx.Abs(c.At(i, j)-cmplx.Conj(c.At(j, i))) <= tol) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// brief: Reports whether the columns of c are orthonormal
+//
+// details: Checks c^H c = I, which for a square c also
+//          means c c^H = I. O(n^3)
+//
+// inputs: tol, the largest deviation allowed in any
+//         entry of c^H c
+//
+// returns: true if c is square and unitary within tol
+func (c *CMatrix) IsUnitary(tol float64) bool {
+	if c.numRows != c.numCols {
+		return false
+	}
+
+	gram, _ := c.ConjTranspose().Multiply(c)
+	for i := 0; i < gram.numRows; i++ {
+		for j, z := range gram.rowView(i) {
+			if i == j {
+				z--
+			}
+			if !(cmplx.Abs(z) <= tol) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
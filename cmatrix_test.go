@@ -0,0 +1,145 @@
+package golinal
+
+import (
+    "github.com/stretchr/testify/suite";
+    "errors";
+    "math";
+    "math/cmplx";
+    "testing"
+)
+
+//*************************************
+// Complex Matrix Test Suite
+//*************************************
+
+type CMatrixTestSuite struct {
+    suite.Suite
+
+    A *CMatrix
+}
+
+func (suite *CMatrixTestSuite) SetupTest() {
+    suite.A, _ = NewCMatrix(
+        []complex128{2, 1 - 1i},
+        []complex128{1 + 1i, 3},
+    )
+}
+
+func (suite *CMatrixTestSuite) TestConstructors() {
+    _, err := NewCMatrix([]complex128{1, 2}, []complex128{3})
+    suite.NotEqual(err, nil, "There should be an error")
+
+    c, err := NewCMatrixFromParts(suite.A.Real(), suite.A.Imag())
+    suite.Equal(err, nil, "There should be no error")
+    suite.Equal(suite.A, c, "They should be equal")
+
+    c, err = NewCMatrixFromParts(ThreeIdentity, nil)
+    suite.Equal(err, nil, "There should be no error")
+    suite.Equal(ThreeIdentity, c.Real(), "They should be equal")
+    suite.Equal(BlankMatrix(3, 3), c.Imag(), "They should be equal")
+
+    _, err = NewCMatrixFromParts(ThreeIdentity, NonsquareMatrix)
+    suite.True(errors.Is(err, &ErrShape{}), "Error should match ErrShape")
+}
+
+func (suite *CMatrixTestSuite) TestConjTranspose() {
+    c, _ := NewCMatrix([]complex128{1i, 2, 3 - 1i})
+    h := c.H()
+    r, cols := h.Dims()
+    suite.Equal(3, r, "They should be equal")
+    suite.Equal(1, cols, "They should be equal")
+    suite.Equal(-1i, h.At(0, 0), "They should be equal")
+    suite.Equal(3+1i, h.At(2, 0), "They should be equal")
+
+    t := c.Transpose()
+    suite.Equal(1i, t.At(0, 0), "Transpose shouldn't conjugate")
+    suite.Equal(3-1i, t.At(2, 0), "Transpose shouldn't conjugate")
+}
+
+func (suite *CMatrixTestSuite) TestAsDense() {
+    d := suite.A.AsDense()
+    d.Set(0, 1, 7i)
+    suite.Equal(7i, suite.A.At(0, 1), "The Dense should share storage")
+
+    data, stride := suite.A.RawData()
+    suite.Equal(2, stride, "They should be equal")
+    suite.Len(data, 4, "They should be equal")
+}
+
+func (suite *CMatrixTestSuite) TestMultiply() {
+    prod, err := suite.A.Multiply(suite.A)
+    suite.Equal(err, nil, "There should be no error")
+    want, _ := NewCMatrix(
+        []complex128{6, 5 - 5i},
+        []complex128{5 + 5i, 11},
+    )
+    suite.Equal(want, prod, "They should be equal")
+
+    _, err = suite.A.Multiply(BlankCMatrix(3, 1))
+    suite.True(errors.Is(err, &ErrShape{}), "Error should match ErrShape")
+    suite.True(errors.Is(suite.A.Add(BlankCMatrix(1, 1)), &ErrShape{}), "Error should match ErrShape")
+}
+
+func (suite *CMatrixTestSuite) TestSolve() {
+    want := []complex128{1 + 2i, -1i}
+    b := []complex128{
+        2*want[0] + (1-1i)*want[1],
+        (1+1i)*want[0] + 3*want[1],
+    }
+    x, err := suite.A.Solve(b)
+    suite.Equal(err, nil, "There should be no error")
+    for i := range x {
+        suite.InDelta(0, cmplx.Abs(x[i]-want[i]), 1e-12, "They should be equal")
+    }
+
+    _, err = BlankCMatrix(2, 2).Solve(b)
+    suite.True(errors.Is(err, &ErrSingular{}), "Error should match ErrSingular")
+    _, err = BlankCMatrix(2, 3).Solve(b)
+    suite.Equal(ErrNotSquare, err, "They should be equal")
+}
+
+func (suite *CMatrixTestSuite) TestPredicates() {
+    suite.True(suite.A.IsHermitian(0), "It should be Hermitian")
+    suite.A.Set(0, 0, 2+1e-3i)
+    suite.False(suite.A.IsHermitian(1e-6), "A complex diagonal isn't Hermitian")
+    suite.True(suite.A.IsHermitian(1e-2), "It should be Hermitian within tol")
+
+    s := complex(1/math.Sqrt2, 0)
+    u, _ := NewCMatrix(
+        []complex128{s, s * 1i},
+        []complex128{s * 1i, s},
+    )
+    suite.True(u.IsUnitary(1e-12), "It should be unitary")
+    suite.False(u.IsHermitian(1e-12), "It shouldn't be Hermitian")
+    suite.False(suite.A.IsUnitary(1e-12), "It shouldn't be unitary")
+    suite.False(BlankCMatrix(2, 1).IsUnitary(1), "A non-square CMatrix isn't unitary")
+
+    // NaN compares false, it mustn't pass as within tol
+    u.Set(1, 0, complex(math.NaN(), 0))
+    suite.False(u.IsUnitary(1), "NaN shouldn't be unitary")
+    nan, _ := NewCMatrix([]complex128{cmplx.NaN()})
+    suite.False(nan.IsHermitian(1), "NaN shouldn't be Hermitian")
+}
+
+func (suite *CMatrixTestSuite) TestEigenvectors() {
+    // Rotation by 90 degrees, eigenvalues +-i
+    rot := MustMatrix(
+        []float64{0, -1},
+        []float64{1, 0},
+    )
+    eig, err := rot.Eigen()
+    suite.Equal(err, nil, "There should be no error")
+
+    v := eig.ComplexVectors()
+    a, _ := NewCMatrixFromParts(rot, nil)
+    av, _ := a.Multiply(v)
+    for j, lambda := range eig.Values() {
+        for i := 0; i < 2; i++ {
+            suite.InDelta(0, cmplx.Abs(av.At(i, j)-lambda*v.At(i, j)), 1e-12, "A v should be lambda v")
+        }
+    }
+}
+
+func TestCMatrix(t *testing.T) {
+    suite.Run(t, new(CMatrixTestSuite))
+}
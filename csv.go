@@ -0,0 +1,174 @@
+package golinal
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// CSVOptions Struct Definition
+//
+// details: Settings for ReadCSV() and WriteCSV(). The zero
+//          value reads and writes plain comma separated values
+//          with no header, shortest round-trip float formatting
+//          and NaN spelled "NaN".
+type CSVOptions struct {
+	// Field delimiter, ',' if zero. Use '\t' for TSV
+	Comma rune
+
+	// ReadCSV only, skips the first record
+	SkipHeader bool
+
+	// Spellings of NaN. ReadCSV reads these fields as NaN
+	// besides the ones strconv.ParseFloat accepts, e.g.
+	// "NA" or "", and WriteCSV writes NaN as the first of
+	// them, or "NaN" if there are none
+	NaNTokens []string
+
+	// ReadCSV only, zero-based indices of the columns to
+	// keep, in the order given. All columns if nil
+	Columns []int
+
+	// WriteCSV only, strconv.FormatFloat format and precision.
+	// A zero Format means 'g' with the shortest precision that
+	// reads back exactly, and Precision is then ignored
+	Format    byte
+	Precision int
+}
+
+// brief: Reads a Matrix from comma (or otherwise) separated values
+//
+// details: One record per row. Quoting follows RFC 4180, as
+//          in encoding/csv, and spaces around fields are ignored.
+//          Every record must have the same number of fields.
+//
+// inputs: opts, may be nil for the defaults
+//
+// returns: the Matrix, or a *ParseError pointing at the line
+//          with a malformed record, an unparsable number, a
+//          different field count, or a missing selected column
+func ReadCSV(r io.Reader, opts *CSVOptions) (*Matrix, error) {
+	if opts == nil {
+		opts = &CSVOptions{}
+	}
+
+	reader := csv.NewReader(r)
+	if opts.Comma != 0 {
+		reader.Comma = opts.Comma
+	}
+	reader.FieldsPerRecord = -1
+	// Leading space would swallow a tab delimiter
+	reader.TrimLeadingSpace = !unicode.IsSpace(reader.Comma)
+	reader.ReuseRecord = true
+
+	nan := make(map[string]bool, len(opts.NaNTokens))
+	for _, token := range opts.NaNTokens {
+		nan[token] = true
+	}
+
+	data := []float64{}
+	rows, fieldsPerRecord := 0, -1
+	columns := opts.Columns
+	for skip := opts.SkipHeader; ; skip = false {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		var csvErr *csv.ParseError
+		if errors.As(err, &csvErr) {
+			return nil, &ParseError{Line: csvErr.Line, Msg: csvErr.Err.Error()}
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+
+		// The first record fixes the field count,
+		// header included
+		if fieldsPerRecord < 0 {
+			fieldsPerRecord = len(record)
+			if columns == nil {
+				columns = make([]int, fieldsPerRecord)
+				for j := range columns {
+					columns[j] = j
+				}
+			}
+			for _, j := range columns {
+				if j < 0 || j >= fieldsPerRecord {
+					return nil, &ParseError{Line: line, Msg: fmt.Sprintf("column %d selected but records have %d fields", j, fieldsPerRecord)}
+				}
+			}
+		} else if len(record) != fieldsPerRecord {
+			return nil, &ParseError{Line: line, Msg: fmt.Sprintf("record has %d fields, expected %d", len(record), fieldsPerRecord)}
+		}
+		if skip {
+			continue
+		}
+
+		for _, j := range columns {
+			field := strings.TrimSpace(record[j])
+			if nan[field] {
+				data = append(data, math.NaN())
+				continue
+			}
+			v, err := strconv.ParseFloat(field, 64)
+			if err != nil {
+				line, _ := reader.FieldPos(j)
+				return nil, &ParseError{Line: line, Msg: fmt.Sprintf("invalid number %q in column %d", field, j)}
+			}
+			data = append(data, v)
+		}
+		rows++
+	}
+
+	return NewMatrixFromData(rows, len(columns), data)
+}
+
+// brief: Writes a Matrix as comma (or otherwise) separated values
+//
+// details: One record per row, with the delimiter and float
+//          formatting of opts
+//
+// inputs: opts, may be nil for the defaults
+//
+// returns: any error from w
+func (m *Matrix) WriteCSV(w io.Writer, opts *CSVOptions) error {
+	if opts == nil {
+		opts = &CSVOptions{}
+	}
+
+	writer := csv.NewWriter(w)
+	if opts.Comma != 0 {
+		writer.Comma = opts.Comma
+	}
+	format, precision := opts.Format, opts.Precision
+	if format == 0 {
+		format, precision = 'g', -1
+	}
+	nan := "NaN"
+	if len(opts.NaNTokens) > 0 {
+		nan = opts.NaNTokens[0]
+	}
+
+	record := make([]string, m.numCols)
+	for i := 0; i < m.numRows; i++ {
+		for j, v := range m.rowView(i) {
+			if math.IsNaN(v) {
+				record[j] = nan
+			} else {
+				record[j] = strconv.FormatFloat(v, format, precision, 64)
+			}
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
@@ -0,0 +1,108 @@
+package golinal
+
+import (
+    "github.com/stretchr/testify/suite";
+    "bytes";
+    "errors";
+    "math";
+    "strings";
+    "testing"
+)
+
+//*******************************
+// CSV Test Suite
+//*******************************
+
+type CSVTestSuite struct {
+    suite.Suite
+}
+
+func (suite *CSVTestSuite) TestRead() {
+    m, err := ReadCSV(strings.NewReader("1,2,3\n4, 5.5 ,-6e1\n"), nil)
+
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal(MustMatrix([]float64{1, 2, 3}, []float64{4, 5.5, -60}), m, "They should be equal")
+
+    m, err = ReadCSV(strings.NewReader(""), nil)
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal(BlankMatrix(0, 0), m, "They should be equal")
+}
+
+func (suite *CSVTestSuite) TestReadOptions() {
+    input := "id\theight\tweight\tnote\n" +
+             "1\t1.80\tNA\t\"a, b\"\n" +
+             "2\t\t72.5\tc\n"
+
+    m, err := ReadCSV(strings.NewReader(input), &CSVOptions{
+        Comma:      '\t',
+        SkipHeader: true,
+        NaNTokens:  []string{"NA", ""},
+        Columns:    []int{2, 1},
+    })
+    suite.Equal(nil, err, "There should be no error")
+
+    r, c := m.Dims()
+    suite.Equal([]int{2, 2}, []int{r, c}, "They should be equal")
+    suite.True(math.IsNaN(m.At(0, 0)), "NA should read as NaN")
+    suite.Equal(1.80, m.At(0, 1), "They should be equal")
+    suite.Equal(72.5, m.At(1, 0), "They should be equal")
+    suite.True(math.IsNaN(m.At(1, 1)), "Empty field should read as NaN")
+}
+
+func (suite *CSVTestSuite) TestReadErrors() {
+    cases := []struct {
+        input string
+        opts  *CSVOptions
+        line  int
+        msg   string
+    }{
+        {"1,2\n3\n", nil, 2, "record has 1 fields, expected 2"},
+        {"1,2\n3,x\n", nil, 2, "invalid number \"x\" in column 1"},
+        {"1,2\n3,\n", nil, 2, "invalid number \"\""},
+        {"1,\"2\n", nil, 1, "extraneous or missing"},
+        {"a,b\n1,2\n", &CSVOptions{Columns: []int{2}}, 1, "column 2 selected"},
+        {"a,b,c\n1,2\n", &CSVOptions{SkipHeader: true}, 2, "expected 3"},
+    }
+
+    for _, c := range cases {
+        _, err := ReadCSV(strings.NewReader(c.input), c.opts)
+
+        var parse *ParseError
+        suite.True(errors.As(err, &parse), "Error should be a *ParseError for %q", c.input)
+        if parse != nil {
+            suite.Equal(c.line, parse.Line, "They should be equal for %q", c.input)
+            suite.Contains(parse.Msg, c.msg)
+        }
+    }
+}
+
+func (suite *CSVTestSuite) TestWrite() {
+    m := MustMatrix([]float64{1, 0.1, math.NaN()}, []float64{-2.5, 1e21, math.Inf(1)})
+
+    var buf bytes.Buffer
+    suite.Equal(nil, m.WriteCSV(&buf, nil), "There should be no error")
+    suite.Equal("1,0.1,NaN\n-2.5,1e+21,+Inf\n", buf.String(), "They should be equal")
+
+    buf.Reset()
+    suite.Equal(nil, m.WriteCSV(&buf, &CSVOptions{Comma: '\t', Format: 'f', Precision: 2, NaNTokens: []string{"NA"}}), "There should be no error")
+    suite.Equal("1.00\t0.10\tNA\n-2.50\t1000000000000000000000.00\t+Inf\n", buf.String(), "They should be equal")
+}
+
+// The default formatting reads back exactly
+func (suite *CSVTestSuite) TestRoundTrip() {
+    for _, m := range []*Matrix{RandMatrix, RandFourMatrix, NonsquareMatrix, randomMatrix(7, 3, 11)} {
+        for _, comma := range []rune{',', '\t', ';'} {
+            var buf bytes.Buffer
+            opts := &CSVOptions{Comma: comma}
+            suite.Equal(nil, m.WriteCSV(&buf, opts), "There should be no error")
+
+            read, err := ReadCSV(&buf, opts)
+            suite.Equal(nil, err, "There should be no error")
+            suite.Equal(m, read, "They should be equal")
+        }
+    }
+}
+
+func TestCSV(t *testing.T) {
+    suite.Run(t, new(CSVTestSuite))
+}
@@ -0,0 +1,346 @@
+package golinal
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+)
+
+// Scalar is the set of element types a Dense matrix can hold
+type Scalar interface {
+	Integer | Field
+}
+
+// Integer is the set of integer element types. Integer
+// Dense matrices can be added, multiplied and transposed,
+// but not factorized
+type Integer interface {
+	int | int8 | int16 | int32 | int64 | uint | uint8 | uint16 | uint32 | uint64
+}
+
+// Field is the set of element types with division, the
+// ones an LU factorization works in
+type Field interface {
+	float32 | float64 | complex64 | complex128
+}
+
+// Dense Struct Definition
+//
+// details: A Matrix with entries of type T, stored the same
+//          way: row-major with entry (i, j) at data[i*stride + j].
+//          Dense[float32] halves the memory of a Matrix, and
+//          the complex types hold what Eigenvalues() returns.
+//          Matrix is defined as a Dense[float64] with the full
+//          float64 API, AsDense() and AsMatrix() convert between
+//          the two without copying, and both use the same
+//          multiply and LU kernels.
+type Dense[T Scalar] struct {
+	numRows, numCols int
+	stride           int
+	data             []T
+}
+
+// brief: Constructor that wraps an existing row-major buffer
+//
+// details: No copy is made, so the Dense and the caller
+//          share storage. Entry (i, j) is data[i*cols + j]
+//
+// returns: a pointer to a Dense, or an error if
+//          len(data) isn't rows*cols
+func NewDense[T Scalar](rows, cols int, data []T) (*Dense[T], error) {
+	if rows < 0 || cols < 0 || len(data) != rows*cols {
+		return nil, fmt.Errorf("Data of length %d can't hold a %dx%d Matrix", len(data), rows, cols)
+	}
+
+	return &Dense[T]{numRows: rows, numCols: cols, stride: cols, data: data}, nil
+}
+
+// brief: Constructor for a zero Dense
+//
+// returns: a pointer to a rows x cols Dense
+func BlankDense[T Scalar](rows, cols int) *Dense[T] {
+	return &Dense[T]{numRows: rows, numCols: cols, stride: cols, data: make([]T, rows*cols)}
+}
+
+// brief: Views a Matrix as a Dense[float64]
+//
+// returns: a pointer to a Dense sharing storage with m
+func (m *Matrix) AsDense() *Dense[float64] {
+	return (*Dense[float64])(m)
+}
+
+// brief: Views a Dense[float64] as a Matrix
+//
+// returns: a pointer to a Matrix sharing storage with d
+func AsMatrix(d *Dense[float64]) *Matrix {
+	return (*Matrix)(d)
+}
+
+// brief: Gets the dimensions of a Dense
+//
+// returns: the number of rows, the number of columns
+func (d *Dense[T]) Dims() (int, int) {
+	return d.numRows, d.numCols
+}
+
+// brief: Get the row,col'th entry of a Dense
+//
+// note: it is undefined behavior to use invalid indices with At()
+//
+// returns: A_ij
+func (d *Dense[T]) At(row, col int) T {
+	return d.data[row*d.stride+col]
+}
+
+// brief: Set the row,col'th entry of a Dense
+//
+// note: it is undefined behavior to use invalid indices with Set()
+func (d *Dense[T]) Set(row, col int, x T) {
+	d.data[row*d.stride+col] = x
+}
+
+// brief: Gives direct access to the backing storage
+//
+// returns: the backing slice and the row stride
+func (d *Dense[T]) RawData() ([]T, int) {
+	return d.data, d.stride
+}
+
+// brief: Adds q to d in place
+//
+// returns: an *ErrShape if the dimensions differ
+func (d *Dense[T]) Add(q *Dense[T]) error {
+	if d.numRows != q.numRows || d.numCols != q.numCols {
+		return errShape("add", d.numRows, d.numCols, q.numRows, q.numCols)
+	}
+	for i := 0; i < d.numRows; i++ {
+		axpyT(1, q.rowView(i), d.rowView(i))
+	}
+
+	return nil
+}
+
+// brief: Multiplys d by q
+//
+// details: The cache-blocked, parallel kernel behind
+//          Matrix.Multiply(). O(n^3)
+//
+// returns: the product, or an *ErrShape if d has a
+//          different number of columns than q has rows
+func (d *Dense[T]) Multiply(q *Dense[T]) (*Dense[T], error) {
+	if d.numCols != q.numRows {
+		return nil, errShape("multiply", d.numRows, d.numCols, q.numRows, q.numCols)
+	}
+
+	result := BlankDense[T](d.numRows, q.numCols)
+	multiply(result, d, q)
+
+	return result, nil
+}
+
+// brief: Calculates the transpose of a Dense
+//
+// note: complex entries aren't conjugated
+//
+// returns: the transpose as a new Dense
+func (d *Dense[T]) Transpose() *Dense[T] {
+	result := BlankDense[T](d.numCols, d.numRows)
+	for i := 0; i < d.numRows; i++ {
+		for j, v := range d.rowView(i) {
+			result.data[j*result.stride+i] = v
+		}
+	}
+
+	return result
+}
+
+// DenseLU Struct Definition
+//
+// details: The PA = LU factorization of a square Dense, packed
+//          as for LU
+type DenseLU[T Field] struct {
+	lu    *Dense[T]
+	pivot []int
+	sign  T
+
+	// First zero pivot, -1 if A is nonsingular
+	zeroPivot int
+}
+
+// brief: Calculates the LUP decomposition of a Dense
+//
+// details: Partial pivoting as in Matrix.LUP(), with the
+//          modulus picking the pivot of a complex column.
+//          Float32 factorizations are computed in float32.
+//          A function rather than a method, as only a Field
+//          has the division LU needs. d is not modified. O(n^3)
+//
+// returns: the factorization of d, or ErrNotSquare
+func DenseLUP[T Field](d *Dense[T]) (*DenseLU[T], error) {
+	if d.numRows != d.numCols {
+		return nil, ErrNotSquare
+	}
+
+	a := d.copy()
+	pivot, sign, zeroPivot := factorLU(a)
+
+	return &DenseLU[T]{lu: a, pivot: pivot, sign: sign, zeroPivot: zeroPivot}, nil
+}
+
+// brief: Reports whether a zero pivot was met
+//
+// returns: true if the factorized Dense is singular
+func (f *DenseLU[T]) IsSingular() bool {
+	return f.zeroPivot >= 0
+}
+
+// brief: Solves Ax = b using the factorization
+//
+// returns: x, an *ErrSingular if A is singular or an
+//          *ErrShape if b has the wrong length
+func (f *DenseLU[T]) Solve(b []T) ([]T, error) {
+	n := f.lu.numRows
+	if len(b) != n {
+		return nil, errShape("solve", n, n, len(b), 1)
+	}
+	if f.IsSingular() {
+		return nil, &ErrSingular{Index: f.zeroPivot}
+	}
+
+	x := make([]T, n)
+	for i, p := range f.pivot {
+		x[i] = b[p]
+	}
+
+	// Solve Ly = Pb, then Ux = y
+	for i := 1; i < n; i++ {
+		x[i] -= dotT(f.lu.rowView(i)[:i], x)
+	}
+	for i := n - 1; i >= 0; i-- {
+		row := f.lu.rowView(i)
+		x[i] = (x[i] - dotT(row[i+1:], x[i+1:])) / row[i]
+	}
+
+	return x, nil
+}
+
+// brief: Calculates the determinant of the factorized Dense
+//
+// returns: the determinant, 0 if A is singular
+func (f *DenseLU[T]) Det() T {
+	det := f.sign
+	for i := 0; i < f.lu.numRows; i++ {
+		det *= f.lu.At(i, i)
+	}
+
+	return det
+}
+
+///////////////////////////////
+//         HELPER            //
+//         FUNCTIONS         //
+///////////////////////////////
+
+// brief: Makes a deep, compact copy of a Dense
+func (d *Dense[T]) copy() *Dense[T] {
+	c := BlankDense[T](d.numRows, d.numCols)
+	for i := 0; i < d.numRows; i++ {
+		copy(c.rowView(i), d.rowView(i))
+	}
+
+	return c
+}
+
+// brief: Gets row i of the backing storage without copying
+func (d *Dense[T]) rowView(i int) []T {
+	return d.data[i*d.stride : i*d.stride+d.numCols]
+}
+
+// brief: Factorizes a square Dense in place as PA = LU,
+//        the elimination behind Matrix.LUP() and DenseLUP()
+//
+// returns: the permutation, its sign, and the first zero
+//          pivot or -1
+func factorLU[T Field](a *Dense[T]) ([]int, T, int) {
+	n := a.numRows
+	pivot := make([]int, n)
+	for i := range pivot {
+		pivot[i] = i
+	}
+	sign := T(1)
+	zeroPivot := -1
+
+	for k := 0; k < n; k++ {
+
+		// Find the row with the largest entry in column k
+		p := k
+		max := modulus(a.At(k, k))
+		for i := k + 1; i < n; i++ {
+			if v := modulus(a.At(i, k)); v > max {
+				max = v
+				p = i
+			}
+		}
+
+		if p != k {
+			swap(a.rowView(k), a.rowView(p))
+			pivot[k], pivot[p] = pivot[p], pivot[k]
+			sign = -sign
+		}
+
+		// The whole column is zero, nothing to eliminate
+		rowK := a.rowView(k)
+		if rowK[k] == 0 {
+			if zeroPivot < 0 {
+				zeroPivot = k
+			}
+			continue
+		}
+
+		// l_{ik} = a_{ik} / u_{kk}
+		// a_{ij} = a_{ij} - l_{ik} u_{kj}
+		for i := k + 1; i < n; i++ {
+			rowI := a.rowView(i)
+			rowI[k] /= rowK[k]
+			axpyT(-rowI[k], rowK[k+1:], rowI[k+1:])
+		}
+	}
+
+	return pivot, sign, zeroPivot
+}
+
+// brief: y += alpha * x for any Scalar
+func axpyT[T Scalar](alpha T, x, y []T) {
+	if alpha == 0 {
+		return
+	}
+	for i, v := range x {
+		y[i] += alpha * v
+	}
+}
+
+// brief: Sum of x_i * y_i for any Scalar, unconjugated
+func dotT[T Scalar](x, y []T) T {
+	var sum T
+	for i, v := range x {
+		sum += v * y[i]
+	}
+
+	return sum
+}
+
+// brief: |x| for any Field, the modulus if complex
+func modulus[T Field](x T) float64 {
+	switch v := any(x).(type) {
+	case float32:
+		return math.Abs(float64(v))
+	case float64:
+		return math.Abs(v)
+	case complex64:
+		return cmplx.Abs(complex128(v))
+	case complex128:
+		return cmplx.Abs(v)
+	}
+
+	return math.NaN()
+}
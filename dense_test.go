@@ -0,0 +1,146 @@
+package golinal
+
+import (
+    "github.com/stretchr/testify/suite";
+    "errors";
+    "math/cmplx";
+    "testing"
+)
+
+//*************************************
+// Generic Dense Test Suite
+//*************************************
+
+type DenseTestSuite struct {
+    suite.Suite
+}
+
+// Runs the same checks for every element type
+func checkDense[T Scalar](suite *DenseTestSuite) {
+    a, err := NewDense(2, 2, []T{2, 1, 4, 3})
+    suite.Equal(err, nil, "There should be no error")
+    b, _ := NewDense(2, 2, []T{1, 0, 1, 1})
+
+    prod, err := a.Multiply(b)
+    suite.Equal(err, nil, "There should be no error")
+    suite.Equal([]T{3, 1, 7, 3}, prod.data, "They should be equal")
+
+    suite.Equal(a.Add(b), nil, "There should be no error")
+    suite.Equal([]T{3, 1, 5, 4}, a.data, "They should be equal")
+    suite.Equal([]T{3, 5, 1, 4}, a.Transpose().data, "They should be equal")
+
+    _, err = a.Multiply(BlankDense[T](3, 1))
+    suite.True(errors.Is(err, &ErrShape{}), "Error should match ErrShape")
+    suite.True(errors.Is(a.Add(BlankDense[T](1, 2)), &ErrShape{}), "Error should match ErrShape")
+}
+
+// Runs the factorization checks for every Field
+func checkDenseLU[T Field](suite *DenseTestSuite) {
+    checkDense[T](suite)
+    a, _ := NewDense(2, 2, []T{3, 1, 5, 4})
+
+    // 3x1 + 5x2 = 11, x1 + 4x2 = 6, so x = (2, 1)
+    lu, err := DenseLUP(a.Transpose())
+    suite.Equal(err, nil, "There should be no error")
+    x, err := lu.Solve([]T{11, 6})
+    suite.Equal(err, nil, "There should be no error")
+    suite.InDelta(2, modulus(x[0]), 1e-6, "They should be equal")
+    suite.InDelta(1, modulus(x[1]), 1e-6, "They should be equal")
+    suite.InDelta(7, modulus(lu.Det()), 1e-6, "They should be equal")
+
+    _, err = DenseLUP(BlankDense[T](2, 3))
+    suite.Equal(ErrNotSquare, err, "They should be equal")
+
+    singular, _ := DenseLUP(BlankDense[T](2, 2))
+    suite.True(singular.IsSingular(), "It should be singular")
+    _, err = singular.Solve([]T{1, 1})
+    suite.True(errors.Is(err, &ErrSingular{}), "Error should match ErrSingular")
+}
+
+func (suite *DenseTestSuite) TestFloat32() {
+    checkDenseLU[float32](suite)
+}
+
+func (suite *DenseTestSuite) TestFloat64() {
+    checkDenseLU[float64](suite)
+}
+
+func (suite *DenseTestSuite) TestComplex64() {
+    checkDenseLU[complex64](suite)
+}
+
+func (suite *DenseTestSuite) TestComplex128() {
+    checkDenseLU[complex128](suite)
+}
+
+func (suite *DenseTestSuite) TestInt() {
+    checkDense[int](suite)
+}
+
+func (suite *DenseTestSuite) TestUint8() {
+    checkDense[uint8](suite)
+
+    // Integer arithmetic wraps, as in Go
+    a, _ := NewDense(1, 1, []uint8{16})
+    prod, _ := a.Multiply(a)
+    suite.Equal(uint8(0), prod.At(0, 0), "They should be equal")
+}
+
+func (suite *DenseTestSuite) TestIntBlocked() {
+    // Large enough for the blocked, parallel kernel
+    a, b := BlankDense[int64](130, 70), BlankDense[int64](70, 90)
+    for i := range a.data {
+        a.data[i] = int64(i%7 - 3)
+    }
+    for i := range b.data {
+        b.data[i] = int64(i%5 - 2)
+    }
+    prod, err := a.Multiply(b)
+    suite.Equal(err, nil, "There should be no error")
+    for i := 0; i < 130; i++ {
+        for j := 0; j < 90; j++ {
+            var want int64
+            for k := 0; k < 70; k++ {
+                want += a.At(i, k) * b.At(k, j)
+            }
+            suite.Equal(want, prod.At(i, j), "They should be equal")
+        }
+    }
+}
+
+func (suite *DenseTestSuite) TestComplexSolve() {
+    // A = [[i, 1], [1, i]], det = -2
+    a, _ := NewDense(2, 2, []complex128{1i, 1, 1, 1i})
+    lu, err := DenseLUP(a)
+    suite.Equal(err, nil, "There should be no error")
+    suite.InDelta(0, cmplx.Abs(lu.Det()+2), 1e-12, "They should be equal")
+
+    want := []complex128{1 - 2i, 3i}
+    b := make([]complex128, 2)
+    for i := range b {
+        b[i] = a.At(i, 0)*want[0] + a.At(i, 1)*want[1]
+    }
+    x, err := lu.Solve(b)
+    suite.Equal(err, nil, "There should be no error")
+    for i := range x {
+        suite.InDelta(0, cmplx.Abs(x[i]-want[i]), 1e-12, "They should be equal")
+    }
+}
+
+func (suite *DenseTestSuite) TestMatrixConversion() {
+    m := RandFourMatrix.Copy()
+    d := m.AsDense()
+    d.Set(1, 2, 42)
+    suite.Equal(42.0, m.At(1, 2), "The Dense should share storage")
+
+    dm, _ := d.Multiply(d)
+    mm, _ := m.Multiply(m)
+    suite.True(EqualApprox(mm, AsMatrix(dm), 0, 1e-12), "They should be equal")
+
+    v, _ := m.Slice(1, 3, 1, 3)
+    suite.Equal(v.rows(), AsMatrix(v.AsDense()).rows(), "Views should convert")
+}
+
+func TestDense(t *testing.T) {
+    suite.Run(t, new(DenseTestSuite))
+}
@@ -0,0 +1,934 @@
+package golinal
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Iterations allowed per eigenvalue before giving up
+const maxEigenIter = 100
+
+// Eigen Struct Definition
+//
+// details: Holds the eigenvalues of a square Matrix A and the
+//          matching eigenvectors, so that A v_j = lambda_j v_j.
+//          Complex eigenvalues come in conjugate pairs, and
+//          so do their eigenvectors. Each eigenvector has
+//          unit 2-norm.
+type Eigen struct {
+	values       []complex128
+	vecRe, vecIm *Matrix
+}
+
+// EigenSym Struct Definition
+//
+// details: Holds the eigenvalues of a symmetric Matrix A in
+//          ascending order and an orthogonal Matrix V whose
+//          columns are the matching eigenvectors, A = V D V^T.
+type EigenSym struct {
+	values  []float64
+	vectors *Matrix
+}
+
+// brief: Calculates the eigenvalues and eigenvectors of a Matrix
+//
+// details: A is reduced to upper Hessenberg form by orthogonal
+//          similarity transformations, then to real Schur form
+//          by the shifted double QR algorithm. The eigenvectors
+//          are found by back substitution, as in EISPACK's
+//          orthes and hqr2. A symmetric A takes the EigenSym()
+//          path instead. O(n^3)
+//
+// returns: the decomposition, or an error if m isn't square,
+//          has NaN or infinite entries, or one wrapping
+//          ErrNotConverged if the QR algorithm doesn't converge
+func (m *Matrix) Eigen() (*Eigen, error) {
+	if !m.IsSquare() {
+		return nil, ErrNotSquare
+	}
+	if !m.isFinite() {
+		return nil, errors.New("Matrix has NaN or infinite entries")
+	}
+
+	if m.IsSymmetric(0) {
+		sym, err := m.EigenSym()
+		if err != nil {
+			return nil, err
+		}
+
+		n := m.numRows
+		values := make([]complex128, n)
+		for i, v := range sym.values {
+			values[i] = complex(v, 0)
+		}
+		return &Eigen{values: values, vecRe: sym.vectors, vecIm: BlankMatrix(n, n)}, nil
+	}
+
+	n := m.numRows
+	Hm, Vm := m.copy(), BlankMatrix(n, n)
+	H, V := Hm.rows(), Vm.rows()
+	d, e := make([]float64, n), make([]float64, n)
+
+	orthes(H, V)
+	if err := hqr2(H, V, d, e); err != nil {
+		return nil, err
+	}
+
+	// V holds a real basis: for a complex pair (j, j+1) with
+	// e_j > 0, column j is the real and column j+1 the
+	// imaginary part of the eigenvector of d_j + i e_j
+	values := make([]complex128, n)
+	re, im := BlankMatrix(n, n), BlankMatrix(n, n)
+	for j := 0; j < n; j++ {
+		values[j] = complex(d[j], e[j])
+		switch {
+		case e[j] == 0:
+			for i := 0; i < n; i++ {
+				re.data[i*re.stride+j] = V[i][j]
+			}
+		case e[j] > 0:
+			for i := 0; i < n; i++ {
+				re.data[i*re.stride+j] = V[i][j]
+				im.data[i*im.stride+j] = V[i][j+1]
+				re.data[i*re.stride+j+1] = V[i][j]
+				im.data[i*im.stride+j+1] = -V[i][j+1]
+			}
+		}
+	}
+
+	// Scale every eigenvector to unit length
+	for j := 0; j < n; j++ {
+		norm := 0.0
+		for i := 0; i < n; i++ {
+			norm = math.Hypot(norm, math.Hypot(re.At(i, j), im.At(i, j)))
+		}
+		if norm == 0 {
+			continue
+		}
+		for i := 0; i < n; i++ {
+			re.data[i*re.stride+j] /= norm
+			im.data[i*im.stride+j] /= norm
+		}
+	}
+
+	return &Eigen{values: values, vecRe: re, vecIm: im}, nil
+}
+
+// brief: Gets the eigenvalues
+//
+// returns: a copy of the eigenvalues
+func (e *Eigen) Values() []complex128 {
+	values := make([]complex128, len(e.values))
+	copy(values, e.values)
+
+	return values
+}
+
+// brief: Gets the eigenvectors
+//
+// details: Column j of re + i*im is the eigenvector
+//          belonging to Values()[j]
+//
+// returns: the real and imaginary parts as new matrices
+func (e *Eigen) Vectors() (*Matrix, *Matrix) {
+	return e.vecRe.copy(), e.vecIm.copy()
+}
+
+// brief: Gets the eigenvectors as one complex Matrix
+//
+// details: Column j is the eigenvector belonging to
+//          Values()[j], so A V = V D with D = diag(Values())
+//
+// returns: a new CMatrix
+func (e *Eigen) ComplexVectors() *CMatrix {
+	v, _ := NewCMatrixFromParts(e.vecRe, e.vecIm)
+
+	return v
+}
+
+// brief: Calculates the eigenvalues and eigenvectors of a
+//        symmetric Matrix
+//
+// details: Householder tridiagonalization followed by the
+//          implicit QL algorithm, as in EISPACK's tred2 and
+//          tql2. Only the lower triangle of m is read, m is
+//          assumed to be symmetric. O(n^3)
+//
+// returns: the decomposition, or an error if m isn't square,
+//          has NaN or infinite entries, or one wrapping
+//          ErrNotConverged if the QL algorithm doesn't converge
+func (m *Matrix) EigenSym() (*EigenSym, error) {
+	if !m.IsSquare() {
+		return nil, ErrNotSquare
+	}
+	if !m.isFinite() {
+		return nil, errors.New("Matrix has NaN or infinite entries")
+	}
+
+	n := m.numRows
+	Vm := BlankMatrix(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			Vm.data[i*Vm.stride+j] = m.At(i, j)
+			Vm.data[j*Vm.stride+i] = m.At(i, j)
+		}
+	}
+	V := Vm.rows()
+	d, e := make([]float64, n), make([]float64, n)
+
+	if n == 0 {
+		return &EigenSym{values: d, vectors: Vm}, nil
+	}
+
+	tred2(V, d, e)
+	if err := tql2(V, d, e); err != nil {
+		return nil, err
+	}
+
+	return &EigenSym{values: d, vectors: Vm}, nil
+}
+
+// brief: Gets the eigenvalues
+//
+// returns: a copy of the eigenvalues, in ascending order
+func (e *EigenSym) Values() []float64 {
+	values := make([]float64, len(e.values))
+	copy(values, e.values)
+
+	return values
+}
+
+// brief: Gets the eigenvectors
+//
+// details: Column j belongs to Values()[j]
+//
+// returns: the orthogonal Matrix V as a new Matrix
+func (e *EigenSym) Vectors() *Matrix {
+	return e.vectors.copy()
+}
+
+// brief: Finds the eigenvalues of a square Matrix m
+//
+// returns: a slice of complex numbers
+func (m *Matrix) Eigenvalues() ([]complex128, error) {
+	eigen, err := m.Eigen()
+	if err != nil {
+		return nil, err
+	}
+
+	return eigen.values, nil
+}
+
+///////////////////////////////
+//         EISPACK           //
+//         ROUTINES          //
+///////////////////////////////
+
+// brief: Symmetric Householder reduction to tridiagonal form
+//
+// details: On entry V holds A. On exit d and e hold the
+//          diagonal and subdiagonal (in e[1:]) of the
+//          tridiagonal Matrix and V the transformation
+func tred2(V [][]float64, d, e []float64) {
+	n := len(V)
+	for j := 0; j < n; j++ {
+		d[j] = V[n-1][j]
+	}
+
+	for i := n - 1; i > 0; i-- {
+
+		// Scale to avoid under/overflow
+		scale, h := 0.0, 0.0
+		for k := 0; k < i; k++ {
+			scale += math.Abs(d[k])
+		}
+
+		if scale == 0 {
+			e[i] = d[i-1]
+			for j := 0; j < i; j++ {
+				d[j] = V[i-1][j]
+				V[i][j] = 0
+				V[j][i] = 0
+			}
+		} else {
+
+			// Generate Householder vector
+			for k := 0; k < i; k++ {
+				d[k] /= scale
+				h += d[k] * d[k]
+			}
+			f := d[i-1]
+			g := math.Sqrt(h)
+			if f > 0 {
+				g = -g
+			}
+			e[i] = scale * g
+			h -= f * g
+			d[i-1] = f - g
+			for j := 0; j < i; j++ {
+				e[j] = 0
+			}
+
+			// Apply similarity transformation to remaining columns
+			for j := 0; j < i; j++ {
+				f = d[j]
+				V[j][i] = f
+				g = e[j] + V[j][j]*f
+				for k := j + 1; k <= i-1; k++ {
+					g += V[k][j] * d[k]
+					e[k] += V[k][j] * f
+				}
+				e[j] = g
+			}
+			f = 0
+			for j := 0; j < i; j++ {
+				e[j] /= h
+				f += e[j] * d[j]
+			}
+			hh := f / (h + h)
+			for j := 0; j < i; j++ {
+				e[j] -= hh * d[j]
+			}
+			for j := 0; j < i; j++ {
+				f = d[j]
+				g = e[j]
+				for k := j; k <= i-1; k++ {
+					V[k][j] -= f*e[k] + g*d[k]
+				}
+				d[j] = V[i-1][j]
+				V[i][j] = 0
+			}
+		}
+		d[i] = h
+	}
+
+	// Accumulate transformations
+	for i := 0; i < n-1; i++ {
+		V[n-1][i] = V[i][i]
+		V[i][i] = 1
+		h := d[i+1]
+		if h != 0 {
+			for k := 0; k <= i; k++ {
+				d[k] = V[k][i+1] / h
+			}
+			for j := 0; j <= i; j++ {
+				g := 0.0
+				for k := 0; k <= i; k++ {
+					g += V[k][i+1] * V[k][j]
+				}
+				for k := 0; k <= i; k++ {
+					V[k][j] -= g * d[k]
+				}
+			}
+		}
+		for k := 0; k <= i; k++ {
+			V[k][i+1] = 0
+		}
+	}
+	for j := 0; j < n; j++ {
+		d[j] = V[n-1][j]
+		V[n-1][j] = 0
+	}
+	V[n-1][n-1] = 1
+	e[0] = 0
+}
+
+// brief: Symmetric tridiagonal QL algorithm
+//
+// details: On exit d holds the eigenvalues in ascending
+//          order and V the matching eigenvectors
+func tql2(V [][]float64, d, e []float64) error {
+	n := len(V)
+	for i := 1; i < n; i++ {
+		e[i-1] = e[i]
+	}
+	e[n-1] = 0
+
+	f, tst1 := 0.0, 0.0
+	for l := 0; l < n; l++ {
+
+		// Find small subdiagonal element
+		tst1 = math.Max(tst1, math.Abs(d[l])+math.Abs(e[l]))
+		m := l
+		for m < n-1 && math.Abs(e[m]) > eps*tst1 {
+			m++
+		}
+
+		// If m == l, d[l] is an eigenvalue,
+		// otherwise, iterate
+		if m > l {
+			for iter := 0; ; iter++ {
+				if iter == maxEigenIter {
+					return fmt.Errorf("Eigenvalues failed to converge: %w", ErrNotConverged)
+				}
+
+				// Compute implicit shift
+				g := d[l]
+				p := (d[l+1] - g) / (2 * e[l])
+				r := math.Hypot(p, 1)
+				if p < 0 {
+					r = -r
+				}
+				d[l] = e[l] / (p + r)
+				d[l+1] = e[l] * (p + r)
+				dl1 := d[l+1]
+				h := g - d[l]
+				for i := l + 2; i < n; i++ {
+					d[i] -= h
+				}
+				f += h
+
+				// Implicit QL transformation
+				p = d[m]
+				c, c2, c3 := 1.0, 1.0, 1.0
+				el1 := e[l+1]
+				s, s2 := 0.0, 0.0
+				for i := m - 1; i >= l; i-- {
+					c3 = c2
+					c2 = c
+					s2 = s
+					g = c * e[i]
+					h = c * p
+					r = math.Hypot(p, e[i])
+					e[i+1] = s * r
+					s = e[i] / r
+					c = p / r
+					p = c*d[i] - s*g
+					d[i+1] = h + s*(c*g+s*d[i])
+
+					// Accumulate transformation
+					for k := 0; k < n; k++ {
+						h = V[k][i+1]
+						V[k][i+1] = s*V[k][i] + c*h
+						V[k][i] = c*V[k][i] - s*h
+					}
+				}
+				p = -s * s2 * c3 * el1 * e[l] / dl1
+				e[l] = s * p
+				d[l] = c * p
+
+				// Check for convergence
+				if math.Abs(e[l]) <= eps*tst1 {
+					break
+				}
+			}
+		}
+		d[l] += f
+		e[l] = 0
+	}
+
+	// Sort eigenvalues and corresponding vectors
+	for i := 0; i < n-1; i++ {
+		k, p := i, d[i]
+		for j := i + 1; j < n; j++ {
+			if d[j] < p {
+				k, p = j, d[j]
+			}
+		}
+		if k != i {
+			d[k], d[i] = d[i], p
+			for j := 0; j < n; j++ {
+				V[j][i], V[j][k] = V[j][k], V[j][i]
+			}
+		}
+	}
+
+	return nil
+}
+
+// brief: Nonsymmetric reduction to Hessenberg form
+//
+// details: On entry H holds A. On exit H is upper Hessenberg
+//          and V holds the orthogonal transformation
+func orthes(H, V [][]float64) {
+	n := len(H)
+	low, high := 0, n-1
+	ort := make([]float64, n)
+
+	for m := low + 1; m <= high-1; m++ {
+
+		// Scale column
+		scale := 0.0
+		for i := m; i <= high; i++ {
+			scale += math.Abs(H[i][m-1])
+		}
+		if scale == 0 {
+			continue
+		}
+
+		// Compute Householder transformation
+		h := 0.0
+		for i := high; i >= m; i-- {
+			ort[i] = H[i][m-1] / scale
+			h += ort[i] * ort[i]
+		}
+		g := math.Sqrt(h)
+		if ort[m] > 0 {
+			g = -g
+		}
+		h -= ort[m] * g
+		ort[m] -= g
+
+		// Apply Householder similarity transformation
+		// H = (I-u*u'/h)*H*(I-u*u')/h)
+		for j := m; j < n; j++ {
+			f := 0.0
+			for i := high; i >= m; i-- {
+				f += ort[i] * H[i][j]
+			}
+			f /= h
+			for i := m; i <= high; i++ {
+				H[i][j] -= f * ort[i]
+			}
+		}
+		for i := 0; i <= high; i++ {
+			f := 0.0
+			for j := high; j >= m; j-- {
+				f += ort[j] * H[i][j]
+			}
+			f /= h
+			for j := m; j <= high; j++ {
+				H[i][j] -= f * ort[j]
+			}
+		}
+		ort[m] *= scale
+		H[m][m-1] = scale * g
+	}
+
+	// Accumulate transformations
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			V[i][j] = 0
+		}
+		V[i][i] = 1
+	}
+	for m := high - 1; m >= low+1; m-- {
+		if H[m][m-1] == 0 {
+			continue
+		}
+		for i := m + 1; i <= high; i++ {
+			ort[i] = H[i][m-1]
+		}
+		for j := m; j <= high; j++ {
+			g := 0.0
+			for i := m; i <= high; i++ {
+				g += ort[i] * V[i][j]
+			}
+
+			// Double division avoids possible underflow
+			g = (g / ort[m]) / H[m][m-1]
+			for i := m; i <= high; i++ {
+				V[i][j] += g * ort[i]
+			}
+		}
+	}
+}
+
+// brief: Nonsymmetric reduction from Hessenberg to real Schur form
+//
+// details: On exit d + i*e hold the eigenvalues and V the
+//          real basis of eigenvectors described in Eigen()
+func hqr2(H, V [][]float64, d, e []float64) error {
+	nn := len(H)
+	n := nn - 1
+	low, high := 0, nn-1
+	exshift := 0.0
+	var p, q, r, s, z, t, w, x, y float64
+
+	// Compute matrix norm
+	norm := 0.0
+	for i := 0; i < nn; i++ {
+		for j := max(i-1, 0); j < nn; j++ {
+			norm += math.Abs(H[i][j])
+		}
+	}
+
+	// Outer loop over eigenvalue index
+	iter := 0
+	for n >= low {
+
+		// Look for single small sub-diagonal element
+		l := n
+		for l > low {
+			s = math.Abs(H[l-1][l-1]) + math.Abs(H[l][l])
+			if s == 0 {
+				s = norm
+			}
+			if math.Abs(H[l][l-1]) < eps*s {
+				break
+			}
+			l--
+		}
+
+		// Check for convergence
+		if l == n {
+
+			// One root found
+			H[n][n] += exshift
+			d[n] = H[n][n]
+			e[n] = 0
+			n--
+			iter = 0
+
+		} else if l == n-1 {
+
+			// Two roots found
+			w = H[n][n-1] * H[n-1][n]
+			p = (H[n-1][n-1] - H[n][n]) / 2
+			q = p*p + w
+			z = math.Sqrt(math.Abs(q))
+			H[n][n] += exshift
+			H[n-1][n-1] += exshift
+			x = H[n][n]
+
+			if q >= 0 {
+
+				// Real pair
+				if p >= 0 {
+					z = p + z
+				} else {
+					z = p - z
+				}
+				d[n-1] = x + z
+				d[n] = d[n-1]
+				if z != 0 {
+					d[n] = x - w/z
+				}
+				e[n-1] = 0
+				e[n] = 0
+				x = H[n][n-1]
+				s = math.Abs(x) + math.Abs(z)
+				p = x / s
+				q = z / s
+				r = math.Sqrt(p*p + q*q)
+				p /= r
+				q /= r
+
+				// Row modification
+				for j := n - 1; j < nn; j++ {
+					z = H[n-1][j]
+					H[n-1][j] = q*z + p*H[n][j]
+					H[n][j] = q*H[n][j] - p*z
+				}
+
+				// Column modification
+				for i := 0; i <= n; i++ {
+					z = H[i][n-1]
+					H[i][n-1] = q*z + p*H[i][n]
+					H[i][n] = q*H[i][n] - p*z
+				}
+
+				// Accumulate transformations
+				for i := low; i <= high; i++ {
+					z = V[i][n-1]
+					V[i][n-1] = q*z + p*V[i][n]
+					V[i][n] = q*V[i][n] - p*z
+				}
+			} else {
+
+				// Complex pair
+				d[n-1] = x + p
+				d[n] = x + p
+				e[n-1] = z
+				e[n] = -z
+			}
+			n -= 2
+			iter = 0
+
+		} else {
+
+			// No convergence yet
+			if iter == maxEigenIter {
+				return fmt.Errorf("Eigenvalues failed to converge: %w", ErrNotConverged)
+			}
+
+			// Form shift
+			x = H[n][n]
+			y = 0
+			w = 0
+			if l < n {
+				y = H[n-1][n-1]
+				w = H[n][n-1] * H[n-1][n]
+			}
+
+			// Wilkinson's original ad hoc shift
+			if iter == 10 {
+				exshift += x
+				for i := low; i <= n; i++ {
+					H[i][i] -= x
+				}
+				s = math.Abs(H[n][n-1]) + math.Abs(H[n-1][n-2])
+				x = 0.75 * s
+				y = x
+				w = -0.4375 * s * s
+			}
+
+			// MATLAB's new ad hoc shift
+			if iter == 30 {
+				s = (y - x) / 2
+				s = s*s + w
+				if s > 0 {
+					s = math.Sqrt(s)
+					if y < x {
+						s = -s
+					}
+					s = x - w/((y-x)/2+s)
+					for i := low; i <= n; i++ {
+						H[i][i] -= s
+					}
+					exshift += s
+					x = 0.964
+					y = x
+					w = x
+				}
+			}
+
+			iter++
+
+			// Look for two consecutive small sub-diagonal elements
+			m := n - 2
+			for m >= l {
+				z = H[m][m]
+				r = x - z
+				s = y - z
+				p = (r*s-w)/H[m+1][m] + H[m][m+1]
+				q = H[m+1][m+1] - z - r - s
+				r = H[m+2][m+1]
+				s = math.Abs(p) + math.Abs(q) + math.Abs(r)
+				p /= s
+				q /= s
+				r /= s
+				if m == l {
+					break
+				}
+				if math.Abs(H[m][m-1])*(math.Abs(q)+math.Abs(r)) <
+					eps*(math.Abs(p)*(math.Abs(H[m-1][m-1])+math.Abs(z)+math.Abs(H[m+1][m+1]))) {
+					break
+				}
+				m--
+			}
+
+			for i := m + 2; i <= n; i++ {
+				H[i][i-2] = 0
+				if i > m+2 {
+					H[i][i-3] = 0
+				}
+			}
+
+			// Double QR step involving rows l:n and columns m:n
+			for k := m; k <= n-1; k++ {
+				notlast := k != n-1
+				if k != m {
+					p = H[k][k-1]
+					q = H[k+1][k-1]
+					r = 0
+					if notlast {
+						r = H[k+2][k-1]
+					}
+					x = math.Abs(p) + math.Abs(q) + math.Abs(r)
+					if x == 0 {
+						continue
+					}
+					p /= x
+					q /= x
+					r /= x
+				}
+
+				s = math.Sqrt(p*p + q*q + r*r)
+				if p < 0 {
+					s = -s
+				}
+				if s == 0 {
+					continue
+				}
+
+				if k != m {
+					H[k][k-1] = -s * x
+				} else if l != m {
+					H[k][k-1] = -H[k][k-1]
+				}
+				p += s
+				x = p / s
+				y = q / s
+				z = r / s
+				q /= p
+				r /= p
+
+				// Row modification
+				for j := k; j < nn; j++ {
+					p = H[k][j] + q*H[k+1][j]
+					if notlast {
+						p += r * H[k+2][j]
+						H[k+2][j] -= p * z
+					}
+					H[k][j] -= p * x
+					H[k+1][j] -= p * y
+				}
+
+				// Column modification
+				for i := 0; i <= min(n, k+3); i++ {
+					p = x*H[i][k] + y*H[i][k+1]
+					if notlast {
+						p += z * H[i][k+2]
+						H[i][k+2] -= p * r
+					}
+					H[i][k] -= p
+					H[i][k+1] -= p * q
+				}
+
+				// Accumulate transformations
+				for i := low; i <= high; i++ {
+					p = x*V[i][k] + y*V[i][k+1]
+					if notlast {
+						p += z * V[i][k+2]
+						V[i][k+2] -= p * r
+					}
+					V[i][k] -= p
+					V[i][k+1] -= p * q
+				}
+			}
+		}
+	}
+
+	// Backsubstitute to find vectors of upper triangular form
+	if norm == 0 {
+		return nil
+	}
+
+	for n = nn - 1; n >= 0; n-- {
+		p = d[n]
+		q = e[n]
+
+		if q == 0 {
+
+			// Real vector
+			l := n
+			H[n][n] = 1
+			for i := n - 1; i >= 0; i-- {
+				w = H[i][i] - p
+				r = 0
+				for j := l; j <= n; j++ {
+					r += H[i][j] * H[j][n]
+				}
+				if e[i] < 0 {
+					z = w
+					s = r
+					continue
+				}
+
+				l = i
+				if e[i] == 0 {
+					if w != 0 {
+						H[i][n] = -r / w
+					} else {
+						H[i][n] = -r / (eps * norm)
+					}
+				} else {
+
+					// Solve real equations
+					x = H[i][i+1]
+					y = H[i+1][i]
+					q = (d[i]-p)*(d[i]-p) + e[i]*e[i]
+					t = (x*s - z*r) / q
+					H[i][n] = t
+					if math.Abs(x) > math.Abs(z) {
+						H[i+1][n] = (-r - w*t) / x
+					} else {
+						H[i+1][n] = (-s - y*t) / z
+					}
+				}
+
+				// Overflow control
+				t = math.Abs(H[i][n])
+				if (eps*t)*t > 1 {
+					for j := i; j <= n; j++ {
+						H[j][n] /= t
+					}
+				}
+			}
+
+		} else if q < 0 {
+
+			// Complex vector
+			l := n - 1
+
+			// Last vector component imaginary so matrix is triangular
+			if math.Abs(H[n][n-1]) > math.Abs(H[n-1][n]) {
+				H[n-1][n-1] = q / H[n][n-1]
+				H[n-1][n] = -(H[n][n] - p) / H[n][n-1]
+			} else {
+				c := complex(0, -H[n-1][n]) / complex(H[n-1][n-1]-p, q)
+				H[n-1][n-1] = real(c)
+				H[n-1][n] = imag(c)
+			}
+			H[n][n-1] = 0
+			H[n][n] = 1
+			for i := n - 2; i >= 0; i-- {
+				ra, sa := 0.0, 0.0
+				for j := l; j <= n; j++ {
+					ra += H[i][j] * H[j][n-1]
+					sa += H[i][j] * H[j][n]
+				}
+				w = H[i][i] - p
+
+				if e[i] < 0 {
+					z = w
+					r = ra
+					s = sa
+					continue
+				}
+
+				l = i
+				if e[i] == 0 {
+					c := complex(-ra, -sa) / complex(w, q)
+					H[i][n-1] = real(c)
+					H[i][n] = imag(c)
+				} else {
+
+					// Solve complex equations
+					x = H[i][i+1]
+					y = H[i+1][i]
+					vr := (d[i]-p)*(d[i]-p) + e[i]*e[i] - q*q
+					vi := (d[i] - p) * 2 * q
+					if vr == 0 && vi == 0 {
+						vr = eps * norm * (math.Abs(w) + math.Abs(q) + math.Abs(x) + math.Abs(y) + math.Abs(z))
+					}
+					c := complex(x*r-z*ra+q*sa, x*s-z*sa-q*ra) / complex(vr, vi)
+					H[i][n-1] = real(c)
+					H[i][n] = imag(c)
+					if math.Abs(x) > math.Abs(z)+math.Abs(q) {
+						H[i+1][n-1] = (-ra - w*H[i][n-1] + q*H[i][n]) / x
+						H[i+1][n] = (-sa - w*H[i][n] - q*H[i][n-1]) / x
+					} else {
+						c := complex(-r-y*H[i][n-1], -s-y*H[i][n]) / complex(z, q)
+						H[i+1][n-1] = real(c)
+						H[i+1][n] = imag(c)
+					}
+				}
+
+				// Overflow control
+				t = math.Max(math.Abs(H[i][n-1]), math.Abs(H[i][n]))
+				if (eps*t)*t > 1 {
+					for j := i; j <= n; j++ {
+						H[j][n-1] /= t
+						H[j][n] /= t
+					}
+				}
+			}
+		}
+	}
+
+	// Back transformation to get eigenvectors of original matrix
+	for j := nn - 1; j >= low; j-- {
+		for i := low; i <= high; i++ {
+			z = 0
+			for k := low; k <= min(j, high); k++ {
+				z += V[i][k] * H[k][j]
+			}
+			V[i][j] = z
+		}
+	}
+
+	return nil
+}
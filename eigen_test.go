@@ -0,0 +1,111 @@
+package golinal
+
+import (
+    "github.com/stretchr/testify/suite";
+    "math";
+    "testing"
+)
+
+//**********************************
+// Eigendecomposition Test Suite
+//**********************************
+
+type EigenTestSuite struct {
+    suite.Suite
+
+    Rotation,
+    Symmetric *Matrix
+}
+
+func (suite *EigenTestSuite) SetupTest() {
+    // Rotates the plane by 90 degrees and scales z by 2
+    suite.Rotation = MustMatrix(
+        []float64{0, -1, 0},
+        []float64{1,  0, 0},
+        []float64{0,  0, 2})
+
+    suite.Symmetric = MustMatrix(
+        []float64{2, -1,  0},
+        []float64{-1, 2, -1},
+        []float64{0, -1,  2})
+}
+
+// brief: Checks that A v_j = lambda_j v_j for every
+// eigenpair and that each v_j has unit length
+func (suite *EigenTestSuite) checkEigenpairs(A *Matrix, eigen *Eigen) {
+    n := A.NumRows()
+    values := eigen.Values()
+    re, im := eigen.Vectors()
+
+    for j, lambda := range values {
+        norm := 0.0
+        for i := 0; i < n; i++ {
+            var Av complex128
+            for k := 0; k < n; k++ {
+                Av += complex(A.At(i, k), 0) * complex(re.At(k, j), im.At(k, j))
+            }
+            lambdaV := lambda * complex(re.At(i, j), im.At(i, j))
+
+            suite.InDelta(real(lambdaV), real(Av), 1e-9, "Eigenpair %d, row %d", j, i)
+            suite.InDelta(imag(lambdaV), imag(Av), 1e-9, "Eigenpair %d, row %d", j, i)
+            norm += re.At(i, j)*re.At(i, j) + im.At(i, j)*im.At(i, j)
+        }
+        suite.InDelta(1.0, norm, 1e-12, "Eigenvector %d should have unit length", j)
+    }
+}
+
+func (suite *EigenTestSuite) TestGeneral() {
+    eigen, err := suite.Rotation.Eigen()
+
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal([]complex128{0+1i, 0-1i, 2}, eigen.Values(), "They should be equal")
+    suite.checkEigenpairs(suite.Rotation, eigen)
+
+    for _, A := range []*Matrix{RandMatrix, RandFourMatrix, suite.Symmetric} {
+        eigen, err := A.Eigen()
+        suite.Equal(nil, err, "There should be no error")
+        suite.checkEigenpairs(A, eigen)
+    }
+
+    _, err = NonsquareMatrix.Eigen()
+    suite.NotEqual(nil, err, "There should be an error")
+}
+
+func (suite *EigenTestSuite) TestSymmetric() {
+    eigen, err := suite.Symmetric.EigenSym()
+
+    suite.Equal(nil, err, "There should be no error")
+    suite.InDeltaSlice([]float64{2 - math.Sqrt2, 2, 2 + math.Sqrt2}, eigen.Values(), 1e-12)
+
+    // A = V D V^T with V orthogonal
+    V := eigen.Vectors()
+    VtV, _ := V.Transpose().Multiply(V)
+    matrixInDelta(&suite.Suite, Identity(3), VtV, 1e-12)
+
+    D := BlankMatrix(3, 3)
+    for i, v := range eigen.Values() {
+        D.data[i*D.stride+i] = v
+    }
+    VD, _ := V.Multiply(D)
+    VDVt, _ := VD.Multiply(V.Transpose())
+    matrixInDelta(&suite.Suite, suite.Symmetric, VDVt, 1e-12)
+
+    _, err = NonsquareMatrix.EigenSym()
+    suite.NotEqual(nil, err, "There should be an error")
+}
+
+func (suite *EigenTestSuite) TestNotFinite() {
+    _, err := MustMatrix(
+        []float64{1, math.NaN()},
+        []float64{2, 3}).Eigen()
+    suite.NotEqual(nil, err, "There should be an error")
+
+    _, err = MustMatrix(
+        []float64{math.Inf(1), 0},
+        []float64{0, 3}).EigenSym()
+    suite.NotEqual(nil, err, "There should be an error")
+}
+
+func TestEigen(t *testing.T) {
+    suite.Run(t, new(EigenTestSuite))
+}
@@ -0,0 +1,215 @@
+package golinal
+
+import (
+	"math"
+)
+
+///////////////////////////////
+//        IN-PLACE           //
+///////////////////////////////
+
+// brief: Subtracts q from m, storing the difference in m
+//
+// returns: an *ErrShape if the dimensions aren't equal
+func (m *Matrix) Sub(q *Matrix) error {
+	if err := m.sameShape("subtract", q); err != nil {
+		return err
+	}
+	for i := 0; i < m.numRows; i++ {
+		axpy(-1, q.rowView(i), m.rowView(i))
+	}
+
+	return nil
+}
+
+// brief: Multiplies two matrices entry by entry
+//        (Hadamard product), storing the result in m
+//
+// returns: an *ErrShape if the dimensions aren't equal
+func (m *Matrix) MulElem(q *Matrix) error {
+	if err := m.sameShape("multiply element-wise", q); err != nil {
+		return err
+	}
+	for i := 0; i < m.numRows; i++ {
+		mRow, qRow := m.rowView(i), q.rowView(i)
+		for j, x := range qRow {
+			mRow[j] *= x
+		}
+	}
+
+	return nil
+}
+
+// brief: Divides m by q entry by entry, storing the result in m
+//
+// note: division by a zero entry follows IEEE 754,
+//       giving +-Inf or NaN
+//
+// returns: an *ErrShape if the dimensions aren't equal
+func (m *Matrix) DivElem(q *Matrix) error {
+	if err := m.sameShape("divide element-wise", q); err != nil {
+		return err
+	}
+	for i := 0; i < m.numRows; i++ {
+		mRow, qRow := m.rowView(i), q.rowView(i)
+		for j, x := range qRow {
+			mRow[j] /= x
+		}
+	}
+
+	return nil
+}
+
+// brief: Adds a real number to every entry of m
+func (m *Matrix) AddScalar(x float64) {
+	for i := 0; i < m.numRows; i++ {
+		row := m.rowView(i)
+		for j := range row {
+			row[j] += x
+		}
+	}
+}
+
+// brief: Replaces every entry of m with f(i, j, m_ij)
+//
+// details: Entries are visited row by row
+func (m *Matrix) Apply(f func(i, j int, v float64) float64) {
+	for i := 0; i < m.numRows; i++ {
+		row := m.rowView(i)
+		for j, v := range row {
+			row[j] = f(i, j, v)
+		}
+	}
+}
+
+// brief: Flips the sign of every entry of m
+func (m *Matrix) Negate() {
+	m.Scale(-1)
+}
+
+// brief: Replaces every entry of m with its absolute value
+func (m *Matrix) Abs() {
+	for i := 0; i < m.numRows; i++ {
+		row := m.rowView(i)
+		for j, v := range row {
+			row[j] = math.Abs(v)
+		}
+	}
+}
+
+///////////////////////////////
+//       NON-MUTATING        //
+///////////////////////////////
+
+// brief: Adds two matrices together
+//
+// returns: a + b as a new Matrix, or an *ErrShape
+//          if the dimensions aren't equal
+func Add(a, b *Matrix) (*Matrix, error) {
+	c := a.copy()
+	if err := c.Add(b); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// brief: Subtracts b from a
+//
+// returns: a - b as a new Matrix, or an *ErrShape
+//          if the dimensions aren't equal
+func Sub(a, b *Matrix) (*Matrix, error) {
+	c := a.copy()
+	if err := c.Sub(b); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// brief: Multiplies two matrices entry by entry
+//
+// returns: the Hadamard product as a new Matrix, or an
+//          *ErrShape if the dimensions aren't equal
+func MulElem(a, b *Matrix) (*Matrix, error) {
+	c := a.copy()
+	if err := c.MulElem(b); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// brief: Divides a by b entry by entry
+//
+// returns: the quotient as a new Matrix, or an
+//          *ErrShape if the dimensions aren't equal
+func DivElem(a, b *Matrix) (*Matrix, error) {
+	c := a.copy()
+	if err := c.DivElem(b); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// brief: Scales a Matrix by a real number
+//
+// returns: x*a as a new Matrix
+func Scale(a *Matrix, x float64) *Matrix {
+	c := a.copy()
+	c.Scale(x)
+
+	return c
+}
+
+// brief: Adds a real number to every entry of a Matrix
+//
+// returns: the result as a new Matrix
+func AddScalar(a *Matrix, x float64) *Matrix {
+	c := a.copy()
+	c.AddScalar(x)
+
+	return c
+}
+
+// brief: Maps f over the entries of a Matrix
+//
+// returns: the Matrix of f(i, j, a_ij) as a new Matrix
+func Apply(a *Matrix, f func(i, j int, v float64) float64) *Matrix {
+	c := a.copy()
+	c.Apply(f)
+
+	return c
+}
+
+// brief: Flips the sign of every entry of a Matrix
+//
+// returns: -a as a new Matrix
+func Negate(a *Matrix) *Matrix {
+	c := a.copy()
+	c.Negate()
+
+	return c
+}
+
+// brief: Takes the absolute value of every entry of a Matrix
+//
+// returns: |a| as a new Matrix
+func Abs(a *Matrix) *Matrix {
+	c := a.copy()
+	c.Abs()
+
+	return c
+}
+
+// brief: Checks that q has the same dimensions as m
+//
+// returns: nil, or an *ErrShape naming op
+func (m *Matrix) sameShape(op string, q *Matrix) error {
+	if m.numRows != q.numRows || m.numCols != q.numCols {
+		return errShape(op, m.numRows, m.numCols, q.numRows, q.numCols)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package golinal
+
+import (
+    "github.com/stretchr/testify/suite";
+    "errors";
+    "testing"
+)
+
+//*************************************
+// Element-wise Arithmetic Test Suite
+//*************************************
+
+type ElementwiseTestSuite struct {
+    suite.Suite
+
+    A, B *Matrix
+}
+
+// Non-square on purpose, Add and Scale used to
+// loop with the wrong bounds on these
+func (suite *ElementwiseTestSuite) SetupTest() {
+    suite.A = MustMatrix(
+        []float64{1, -2, 3},
+        []float64{-4, 5, -6})
+
+    suite.B = MustMatrix(
+        []float64{2, 2, 2},
+        []float64{4, 5, -3})
+}
+
+func (suite *ElementwiseTestSuite) TestAddScaleNonSquare() {
+    suite.Equal(nil, suite.A.Add(suite.B), "There should be no error")
+    suite.Equal(MustMatrix([]float64{3, 0, 5}, []float64{0, 10, -9}), suite.A, "They should be equal")
+
+    suite.A.Scale(2)
+    suite.Equal(MustMatrix([]float64{6, 0, 10}, []float64{0, 20, -18}), suite.A, "They should be equal")
+
+    tall := MustMatrix([]float64{1, 2}, []float64{3, 4}, []float64{5, 6})
+    suite.True(errors.Is(suite.A.Add(tall), &ErrShape{}), "Error should match ErrShape")
+}
+
+func (suite *ElementwiseTestSuite) TestInPlace() {
+    m := suite.A.copy()
+    suite.Equal(nil, m.Sub(suite.B), "There should be no error")
+    suite.Equal(MustMatrix([]float64{-1, -4, 1}, []float64{-8, 0, -3}), m, "They should be equal")
+
+    m = suite.A.copy()
+    suite.Equal(nil, m.MulElem(suite.B), "There should be no error")
+    suite.Equal(MustMatrix([]float64{2, -4, 6}, []float64{-16, 25, 18}), m, "They should be equal")
+
+    m = suite.A.copy()
+    suite.Equal(nil, m.DivElem(suite.B), "There should be no error")
+    suite.Equal(MustMatrix([]float64{0.5, -1, 1.5}, []float64{-1, 1, 2}), m, "They should be equal")
+
+    m = suite.A.copy()
+    m.AddScalar(1)
+    suite.Equal(MustMatrix([]float64{2, -1, 4}, []float64{-3, 6, -5}), m, "They should be equal")
+
+    m = suite.A.copy()
+    m.Negate()
+    suite.Equal(MustMatrix([]float64{-1, 2, -3}, []float64{4, -5, 6}), m, "They should be equal")
+
+    m = suite.A.copy()
+    m.Abs()
+    suite.Equal(MustMatrix([]float64{1, 2, 3}, []float64{4, 5, 6}), m, "They should be equal")
+
+    m = suite.A.copy()
+    m.Apply(func(i, j int, v float64) float64 { return float64(10*i+j) + v })
+    suite.Equal(MustMatrix([]float64{1, -1, 5}, []float64{6, 16, 6}), m, "They should be equal")
+
+    for _, err := range []error{
+        m.Sub(NonsquareMatrix),
+        m.MulElem(NonsquareMatrix),
+        m.DivElem(NonsquareMatrix),
+    } {
+        suite.True(errors.Is(err, &ErrShape{}), "Error should match ErrShape")
+    }
+}
+
+// The returning forms agree with the in-place ones
+// and leave their arguments untouched
+func (suite *ElementwiseTestSuite) TestNonMutating() {
+    A, B := suite.A.copy(), suite.B.copy()
+
+    inPlace := func(f func(m *Matrix)) *Matrix {
+        m := suite.A.copy()
+        f(m)
+        return m
+    }
+
+    sum, err := Add(A, B)
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal(inPlace(func(m *Matrix) { m.Add(B) }), sum, "They should be equal")
+
+    diff, err := Sub(A, B)
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal(inPlace(func(m *Matrix) { m.Sub(B) }), diff, "They should be equal")
+
+    prod, err := MulElem(A, B)
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal(inPlace(func(m *Matrix) { m.MulElem(B) }), prod, "They should be equal")
+
+    quot, err := DivElem(A, B)
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal(inPlace(func(m *Matrix) { m.DivElem(B) }), quot, "They should be equal")
+
+    suite.Equal(inPlace(func(m *Matrix) { m.Scale(3) }), Scale(A, 3), "They should be equal")
+    suite.Equal(inPlace(func(m *Matrix) { m.AddScalar(3) }), AddScalar(A, 3), "They should be equal")
+    suite.Equal(inPlace(func(m *Matrix) { m.Negate() }), Negate(A), "They should be equal")
+    suite.Equal(inPlace(func(m *Matrix) { m.Abs() }), Abs(A), "They should be equal")
+
+    square := func(i, j int, v float64) float64 { return v * v }
+    suite.Equal(inPlace(func(m *Matrix) { m.Apply(square) }), Apply(A, square), "They should be equal")
+
+    suite.Equal(suite.A, A, "Arguments should be unchanged")
+    suite.Equal(suite.B, B, "Arguments should be unchanged")
+
+    _, err = Add(A, NonsquareMatrix)
+    suite.True(errors.Is(err, &ErrShape{}), "Error should match ErrShape")
+}
+
+func TestElementwise(t *testing.T) {
+    suite.Run(t, new(ElementwiseTestSuite))
+}
@@ -0,0 +1,95 @@
+package golinal
+
+import (
+	"math"
+)
+
+// brief: Compares two matrices entry by entry with a tolerance
+//
+// details: Entries x and y match when
+//          |x - y| <= max(absTol, relTol * max(|x|, |y|)),
+//          so absTol governs entries near zero and relTol
+//          large ones. Infinities only match themselves,
+//          and NaN matches nothing.
+//
+// returns: true if a and b have the same dimensions and
+//          every pair of entries matches
+func EqualApprox(a, b *Matrix, absTol, relTol float64) bool {
+	return equalEntries(a, b, func(x, y float64) bool {
+		return ScalarEqualApprox(x, y, absTol, relTol)
+	})
+}
+
+// brief: Compares two matrices entry by entry in units
+//        in the last place
+//
+// details: Entries match when at most ulps representable
+//          float64s lie between them, so 0 asks for exact
+//          equality. +0 and -0 are 0 ULP apart.
+//
+// returns: true if a and b have the same dimensions and
+//          every pair of entries matches
+func EqualULP(a, b *Matrix, ulps uint) bool {
+	return equalEntries(a, b, func(x, y float64) bool {
+		return ScalarEqualULP(x, y, ulps)
+	})
+}
+
+// brief: Compares two floats with an absolute and a
+//        relative tolerance, as in EqualApprox()
+func ScalarEqualApprox(x, y, absTol, relTol float64) bool {
+	if x == y {
+		return true
+	}
+	if math.IsInf(x, 0) || math.IsInf(y, 0) {
+		return false
+	}
+
+	diff := math.Abs(x - y)
+
+	return diff <= absTol || diff <= relTol*math.Max(math.Abs(x), math.Abs(y))
+}
+
+// brief: Compares two floats in units in the last place,
+//        as in EqualULP()
+func ScalarEqualULP(x, y float64, ulps uint) bool {
+	if math.IsNaN(x) || math.IsNaN(y) {
+		return false
+	}
+
+	a, b := ordered(x), ordered(y)
+	if a < b {
+		a, b = b, a
+	}
+
+	// The gap can exceed math.MaxInt64, but not math.MaxUint64
+	return uint64(a-b) <= uint64(ulps)
+}
+
+// brief: Maps float64s onto int64s in the same order, with
+//        neighbouring floats one apart
+func ordered(x float64) int64 {
+	bits := int64(math.Float64bits(x))
+	if bits < 0 {
+		return math.MinInt64 - bits
+	}
+
+	return bits
+}
+
+// brief: Checks dimensions, then match on every pair of entries
+func equalEntries(a, b *Matrix, match func(x, y float64) bool) bool {
+	if a.numRows != b.numRows || a.numCols != b.numCols {
+		return false
+	}
+	for i := 0; i < a.numRows; i++ {
+		bRow := b.rowView(i)
+		for j, x := range a.rowView(i) {
+			if !match(x, bRow[j]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
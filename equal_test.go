@@ -0,0 +1,58 @@
+package golinal
+
+import (
+    "github.com/stretchr/testify/suite";
+    "math";
+    "testing"
+)
+
+//*************************************
+// Approximate Equality Test Suite
+//*************************************
+
+type EqualTestSuite struct {
+    suite.Suite
+
+    A *Matrix
+}
+
+func (suite *EqualTestSuite) SetupTest() {
+    suite.A = MustMatrix(
+        []float64{1, -2},
+        []float64{1e10, 0},
+    )
+}
+
+func (suite *EqualTestSuite) TestEqualApprox() {
+    close := MustMatrix(
+        []float64{1 + 1e-12, -2},
+        []float64{1e10 + 1, 1e-13},
+    )
+    suite.True(EqualApprox(suite.A, close, 1e-12, 1e-9), "They should be equal")
+    suite.False(EqualApprox(suite.A, close, 1e-12, 0), "Relative tolerance should be needed for the large entry")
+    suite.False(EqualApprox(suite.A, close, 0, 1e-9), "Absolute tolerance should be needed near zero")
+    suite.False(EqualApprox(suite.A, NonsquareMatrix, 1, 1), "Dimensions should have to match")
+}
+
+func (suite *EqualTestSuite) TestEqualApproxSpecial() {
+    suite.True(ScalarEqualApprox(math.Inf(1), math.Inf(1), 0, 0), "Infinities should match themselves")
+    suite.False(ScalarEqualApprox(math.Inf(1), math.MaxFloat64, 1, 1), "Infinity should match nothing finite")
+    suite.False(ScalarEqualApprox(math.NaN(), math.NaN(), 1, 1), "NaN should match nothing")
+}
+
+func (suite *EqualTestSuite) TestEqualULP() {
+    next := suite.A.copy()
+    next.Set(0, 0, math.Nextafter(1, 2))
+    suite.False(EqualULP(suite.A, next, 0), "They should differ by one ULP")
+    suite.True(EqualULP(suite.A, next, 1), "They should be equal")
+    suite.True(EqualULP(suite.A, suite.A, 0), "They should be equal")
+
+    suite.True(ScalarEqualULP(0, math.Copysign(0, -1), 0), "Zeros should be equal")
+    suite.True(ScalarEqualULP(math.SmallestNonzeroFloat64, -math.SmallestNonzeroFloat64, 2), "Denormals either side of zero are 2 ULP apart")
+    suite.False(ScalarEqualULP(math.Inf(-1), math.Inf(1), 1), "The gap between infinities shouldn't overflow")
+    suite.False(ScalarEqualULP(math.NaN(), math.NaN(), math.MaxUint), "NaN should match nothing")
+}
+
+func TestEqual(t *testing.T) {
+    suite.Run(t, new(EqualTestSuite))
+}
@@ -0,0 +1,123 @@
+package golinal
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotSquare is returned by operations that are only
+// defined for square matrices, such as LUP() or Eigen()
+var ErrNotSquare = errors.New("Matrix should be square")
+
+// ErrNotConverged is returned by the iterative solvers when
+// the residual is still above tolerance after MaxIter steps,
+// and wrapped by Eigen() and EigenSym() when the QR or QL
+// iteration runs out of steps
+var ErrNotConverged = errors.New("Iterative solver failed to converge")
+
+// ErrShape Struct Definition
+//
+// details: Returned when the operands of Op have incompatible
+//          dimensions. Rows x Cols is the shape of the receiver
+//          and OtherRows x OtherCols that of the argument. A
+//          Vector or slice of length n counts as n x 1.
+type ErrShape struct {
+	Op         string
+	Rows, Cols int
+
+	OtherRows, OtherCols int
+}
+
+func (e *ErrShape) Error() string {
+	return fmt.Sprintf("Dimensions don't match for %s: %dx%d and %dx%d",
+		e.Op, e.Rows, e.Cols, e.OtherRows, e.OtherCols)
+}
+
+// brief: Lets errors.Is(err, &ErrShape{}) match any ErrShape
+func (e *ErrShape) Is(target error) bool {
+	_, ok := target.(*ErrShape)
+	return ok
+}
+
+// ErrSingular Struct Definition
+//
+// details: Returned when a solve or inverse meets a singular
+//          Matrix. Index is the first zero pivot, the column
+//          at which elimination broke down.
+type ErrSingular struct {
+	Index int
+}
+
+func (e *ErrSingular) Error() string {
+	return fmt.Sprintf("Matrix is singular: pivot %d is zero", e.Index)
+}
+
+// brief: Lets errors.Is(err, &ErrSingular{}) match any ErrSingular
+func (e *ErrSingular) Is(target error) bool {
+	_, ok := target.(*ErrSingular)
+	return ok
+}
+
+// ErrNotPositiveDefinite Struct Definition
+//
+// details: Returned when a Cholesky factorization or downdate
+//          meets a non-positive pivot. Index is the column at
+//          which it happened and Pivot the offending value
+//          (what would have been squared into l_ii). CG returns
+//          it when a search direction has p^T A p <= 0, Index
+//          then being the iteration.
+type ErrNotPositiveDefinite struct {
+	Index int
+	Pivot float64
+}
+
+func (e *ErrNotPositiveDefinite) Error() string {
+	return fmt.Sprintf("Matrix is not positive definite: pivot %d is %g", e.Index, e.Pivot)
+}
+
+// brief: Lets errors.Is(err, &ErrNotPositiveDefinite{}) match
+//        any ErrNotPositiveDefinite
+func (e *ErrNotPositiveDefinite) Is(target error) bool {
+	_, ok := target.(*ErrNotPositiveDefinite)
+	return ok
+}
+
+// ErrIndexOutOfRange Struct Definition
+//
+// details: Returned by the checked accessors when (Row, Col)
+//          lies outside a Rows x Cols Matrix. For a Vector of
+//          length n, Col is 0 and the shape is n x 1.
+type ErrIndexOutOfRange struct {
+	Row, Col   int
+	Rows, Cols int
+}
+
+func (e *ErrIndexOutOfRange) Error() string {
+	return fmt.Sprintf("Index (%d, %d) out of range for %dx%d Matrix",
+		e.Row, e.Col, e.Rows, e.Cols)
+}
+
+// brief: Lets errors.Is(err, &ErrIndexOutOfRange{}) match
+//        any ErrIndexOutOfRange
+func (e *ErrIndexOutOfRange) Is(target error) bool {
+	_, ok := target.(*ErrIndexOutOfRange)
+	return ok
+}
+
+// brief: Shorthand for building an *ErrShape
+func errShape(op string, rows, cols, otherRows, otherCols int) error {
+	return &ErrShape{Op: op, Rows: rows, Cols: cols, OtherRows: otherRows, OtherCols: otherCols}
+}
+
+// ParseError Struct Definition
+//
+// details: Returned by the readers when their input is
+//          malformed. Line counts from 1.
+type ParseError struct {
+	Line int
+	Msg  string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("Line %d: %s", e.Line, e.Msg)
+}
@@ -0,0 +1,109 @@
+package golinal
+
+import (
+    "github.com/stretchr/testify/suite";
+    "errors";
+    "testing"
+)
+
+//*******************************
+// Typed Errors Test Suite
+//*******************************
+
+type ErrorsTestSuite struct {
+    suite.Suite
+
+    Singular *Matrix
+}
+
+func (suite *ErrorsTestSuite) SetupTest() {
+    suite.Singular = MustMatrix(
+        []float64{1, 2, 3},
+        []float64{2, 4, 6},
+        []float64{1, 0, 1})
+}
+
+func (suite *ErrorsTestSuite) TestShape() {
+    _, err := NonsquareMatrix.Multiply(NonsquareMatrix)
+
+    var shape *ErrShape
+    suite.True(errors.As(err, &shape), "Error should be an *ErrShape")
+    suite.Equal("multiply", shape.Op, "They should be equal")
+    suite.Equal([]int{2, 1, 2, 1},
+        []int{shape.Rows, shape.Cols, shape.OtherRows, shape.OtherCols},
+        "They should be equal")
+
+    _, err = NewVector(1, 2).Dot(NewVector(1, 2, 3))
+    suite.True(errors.Is(err, &ErrShape{}), "Error should match ErrShape")
+
+    lu, _ := RandMatrix.LUP()
+    _, err = lu.Solve([]float64{1})
+    suite.True(errors.Is(err, &ErrShape{}), "Error should match ErrShape")
+    suite.False(errors.Is(err, &ErrSingular{}), "Error shouldn't match ErrSingular")
+}
+
+func (suite *ErrorsTestSuite) TestNotSquare() {
+    _, err := NonsquareMatrix.LUP()
+    suite.True(errors.Is(err, ErrNotSquare), "Error should be ErrNotSquare")
+
+    _, err = NonsquareMatrix.Inverse()
+    suite.True(errors.Is(err, ErrNotSquare), "Error should be ErrNotSquare")
+
+    _, err = NonsquareMatrix.Cholesky()
+    suite.True(errors.Is(err, ErrNotSquare), "Error should be ErrNotSquare")
+
+    _, err = NonsquareMatrix.Eigen()
+    suite.True(errors.Is(err, ErrNotSquare), "Error should be ErrNotSquare")
+}
+
+// Elimination breaks down at the last column
+func (suite *ErrorsTestSuite) TestSingular() {
+    _, err := suite.Singular.Inverse()
+
+    var singular *ErrSingular
+    suite.True(errors.As(err, &singular), "Error should be an *ErrSingular")
+    suite.Equal(2, singular.Index, "They should be equal")
+
+    _, err = suite.Singular.Gauss([]float64{1, 2, 3})
+    suite.True(errors.Is(err, &ErrSingular{}), "Error should match ErrSingular")
+
+    // Without pivoting QR reports the first vanishing r_ii
+    tall := MustMatrix(
+        []float64{1, 1},
+        []float64{2, 2},
+        []float64{3, 3})
+    _, err = tall.QR().SolveLeastSquares([]float64{1, 2, 3})
+    suite.True(errors.As(err, &singular), "Error should be an *ErrSingular")
+    suite.Equal(1, singular.Index, "They should be equal")
+}
+
+func (suite *ErrorsTestSuite) TestIndexOutOfRange() {
+    m := BlankMatrix(2, 3)
+
+    suite.Equal(nil, m.SetChecked(1, 2, 5), "There should be no error")
+    x, err := m.AtChecked(1, 2)
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal(5.0, x, "They should be equal")
+
+    // Column 3 would alias row 2 in the backing slice
+    var index *ErrIndexOutOfRange
+    _, err = m.AtChecked(0, 3)
+    suite.True(errors.As(err, &index), "Error should be an *ErrIndexOutOfRange")
+    suite.Equal(ErrIndexOutOfRange{Row: 0, Col: 3, Rows: 2, Cols: 3}, *index, "They should be equal")
+
+    suite.True(errors.Is(m.SetChecked(-1, 0, 1), &ErrIndexOutOfRange{}), "Error should match ErrIndexOutOfRange")
+    expected := BlankMatrix(2, 3)
+    expected.Set(1, 2, 5)
+    suite.Equal(expected, m, "Matrix should be unchanged")
+
+    v := NewVector(1, 2)
+    _, err = v.AtChecked(2)
+    suite.True(errors.Is(err, &ErrIndexOutOfRange{}), "Error should match ErrIndexOutOfRange")
+    suite.True(errors.Is(v.SetChecked(-1, 0), &ErrIndexOutOfRange{}), "Error should match ErrIndexOutOfRange")
+    suite.Equal(nil, v.SetChecked(1, 7), "There should be no error")
+    suite.Equal(7.0, v.At(1), "They should be equal")
+}
+
+func TestErrors(t *testing.T) {
+    suite.Run(t, new(ErrorsTestSuite))
+}
@@ -0,0 +1,196 @@
+package golinal
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// brief: Formats a Matrix for fmt's Print family
+//
+// details: Implements fmt.Formatter. One bracketed line per
+//          row with columns right aligned, e.g. for %v
+//
+//	[1  -2.5]
+//	[3     4]
+//
+//          The floating point verbs %e %f %g (and upper case)
+//          apply to every entry with their width, precision and
+//          the flags '+', ' ' and '-', so %8.3f gives columns at
+//          least 8 wide with 3 decimals. %#v prints a Go expression,
+//          a MustMatrix() call, that rebuilds m exactly. See Elided() for
+//          large matrices.
+func (m Matrix) Format(s fmt.State, verb rune) {
+	if verb == 'v' && s.Flag('#') {
+		m.formatGoSyntax(s)
+		return
+	}
+
+	formatMatrix(s, verb, &m, -1)
+}
+
+// elided formats only the corners of a Matrix
+type elided struct {
+	m      *Matrix
+	corner int
+}
+
+// brief: Wraps a Matrix to print only its corners
+//
+// details: Rows and columns beyond the first and last corner
+//          are replaced by "...", so a huge Matrix prints in a
+//          few lines, e.g. fmt.Printf("%.2f", Elided(m, 3)). A
+//          "Dims(rows, cols)" line comes first when anything was
+//          left out. Verbs and flags are as for Matrix.
+//
+// returns: a fmt.Formatter
+func Elided(m *Matrix, corner int) fmt.Formatter {
+	return elided{m: m, corner: corner}
+}
+
+func (e elided) Format(s fmt.State, verb rune) {
+	formatMatrix(s, verb, e.m, e.corner)
+}
+
+// brief: Writes the rows of m to s
+//
+// inputs: corner, the number of leading and trailing rows and
+//         columns to show, all of them if negative
+func formatMatrix(s fmt.State, verb rune, m *Matrix, corner int) {
+	switch verb {
+	case 'v':
+		verb = 'g'
+	case 'e', 'E', 'f', 'F', 'g', 'G':
+	default:
+		fmt.Fprintf(s, "%%!%c(golinal.Matrix=Dims(%d, %d))", verb, m.numRows, m.numCols)
+		return
+	}
+
+	// Format string for a single entry, padding is done
+	// per column below
+	entry := "%"
+	for _, flag := range "+ " {
+		if s.Flag(int(flag)) {
+			entry += string(flag)
+		}
+	}
+	if precision, ok := s.Precision(); ok {
+		entry += "." + strconv.Itoa(precision)
+	}
+	entry += string(verb)
+
+	rows := visible(m.numRows, corner)
+	cols := visible(m.numCols, corner)
+	if len(rows) < m.numRows || len(cols) < m.numCols {
+		fmt.Fprintf(s, "Dims(%d, %d)\n", m.numRows, m.numCols)
+	}
+
+	width, _ := s.Width()
+	widths := make([]int, len(cols))
+	cells := make([][]string, len(rows))
+	for r, i := range rows {
+		cells[r] = make([]string, len(cols))
+		for c, j := range cols {
+			cell := "..."
+			if i >= 0 && j >= 0 {
+				cell = fmt.Sprintf(entry, m.At(i, j))
+			}
+			cells[r][c] = cell
+			widths[c] = max(widths[c], len(cell), width)
+		}
+	}
+
+	left := s.Flag('-')
+	if len(rows) == 0 {
+		s.Write([]byte("[]"))
+	}
+	for r, row := range cells {
+		if r > 0 {
+			s.Write([]byte("\n"))
+		}
+		s.Write([]byte("["))
+		for c, cell := range row {
+			if c > 0 {
+				s.Write([]byte(" "))
+			}
+			pad := strings.Repeat(" ", widths[c]-len(cell))
+			if left {
+				cell += pad
+			} else {
+				cell = pad + cell
+			}
+			s.Write([]byte(cell))
+		}
+		s.Write([]byte("]"))
+	}
+}
+
+// brief: Picks the indices to show out of n
+//
+// returns: 0..n-1, or the first and last corner indices
+//          with -1 standing for the ones left out
+func visible(n, corner int) []int {
+	var index []int
+	if corner < 0 || n <= 2*corner+1 {
+		for i := 0; i < n; i++ {
+			index = append(index, i)
+		}
+		return index
+	}
+
+	for i := 0; i < corner; i++ {
+		index = append(index, i)
+	}
+	index = append(index, -1)
+	for i := n - corner; i < n; i++ {
+		index = append(index, i)
+	}
+
+	return index
+}
+
+// brief: Writes a Go expression equal to m
+//
+// details: A MustMatrix() call, or BlankMatrix(0, cols) when
+//          there are no rows to carry the number of columns.
+//          Entries use the shortest representation that
+//          parses back to the same float64
+func (m *Matrix) formatGoSyntax(s fmt.State) {
+	if m.numRows == 0 {
+		fmt.Fprintf(s, "BlankMatrix(0, %d)", m.numCols)
+		return
+	}
+
+	var b strings.Builder
+	b.WriteString("MustMatrix(\n")
+	for i := 0; i < m.numRows; i++ {
+		b.WriteString("\t[]float64{")
+		for j, v := range m.rowView(i) {
+			if j > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(goFloat(v))
+		}
+		b.WriteString("},\n")
+	}
+	b.WriteString(")")
+
+	s.Write([]byte(b.String()))
+}
+
+// brief: Spells a float64 as a Go expression
+func goFloat(v float64) string {
+	switch {
+	case math.IsNaN(v):
+		return "math.NaN()"
+	case math.IsInf(v, 1):
+		return "math.Inf(1)"
+	case math.IsInf(v, -1):
+		return "math.Inf(-1)"
+	case v == 0 && math.Signbit(v):
+		return "math.Copysign(0, -1)"
+	}
+
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
@@ -0,0 +1,94 @@
+package golinal
+
+import (
+    "github.com/stretchr/testify/suite";
+    "fmt";
+    "math";
+    "testing"
+)
+
+//*******************************
+// Formatting Test Suite
+//*******************************
+
+type FormatTestSuite struct {
+    suite.Suite
+
+    A *Matrix
+}
+
+func (suite *FormatTestSuite) SetupTest() {
+    suite.A = MustMatrix(
+        []float64{1, -2.5},
+        []float64{30, 4})
+}
+
+func (suite *FormatTestSuite) TestDefault() {
+    suite.Equal("[ 1 -2.5]\n[30    4]", fmt.Sprint(suite.A), "They should be equal")
+    suite.Equal("[ 1 -2.5]\n[30    4]", fmt.Sprintf("%v", *suite.A), "They should be equal")
+    suite.Equal("[]", fmt.Sprint(BlankMatrix(0, 0)), "They should be equal")
+    suite.Equal("[]\n[]", fmt.Sprint(BlankMatrix(2, 0)), "They should be equal")
+    suite.Equal("[NaN +Inf]", fmt.Sprint(MustMatrix([]float64{math.NaN(), math.Inf(1)})), "They should be equal")
+}
+
+func (suite *FormatTestSuite) TestVerbs() {
+    suite.Equal(
+        "[   1.000   -2.500]\n" +
+        "[  30.000    4.000]", fmt.Sprintf("%8.3f", suite.A), "They should be equal")
+    suite.Equal(
+        "[1.0  -2.5]\n" +
+        "[30.0 4.0 ]", fmt.Sprintf("%-.1f", suite.A), "They should be equal")
+    suite.Equal(
+        "[ +1 -2.5]\n" +
+        "[+30   +4]", fmt.Sprintf("%+g", suite.A), "They should be equal")
+    suite.Equal(
+        "[1.00e+00 -2.50e+00]\n" +
+        "[3.00e+01  4.00e+00]", fmt.Sprintf("%.2e", suite.A), "They should be equal")
+    suite.Equal("%!d(golinal.Matrix=Dims(2, 2))", fmt.Sprintf("%d", suite.A), "They should be equal")
+}
+
+func (suite *FormatTestSuite) TestElided() {
+    m := BlankMatrix(6, 7)
+    for k := range m.data {
+        m.data[k] = float64(k)
+    }
+
+    suite.Equal(
+        "Dims(6, 7)\n" +
+        "[  0   1 ...   5   6]\n" +
+        "[  7   8 ...  12  13]\n" +
+        "[... ... ... ... ...]\n" +
+        "[ 28  29 ...  33  34]\n" +
+        "[ 35  36 ...  40  41]", fmt.Sprintf("%v", Elided(m, 2)), "They should be equal")
+
+    // Nothing to leave out, same as the Matrix itself
+    suite.Equal(fmt.Sprintf("%5.1f", suite.A), fmt.Sprintf("%5.1f", Elided(suite.A, 1)), "They should be equal")
+}
+
+// %#v gives source that rebuilds the Matrix exactly
+func (suite *FormatTestSuite) TestGoSyntax() {
+    suite.Equal(
+        "MustMatrix(\n" +
+        "\t[]float64{1, -2.5},\n" +
+        "\t[]float64{30, 4},\n" +
+        ")", fmt.Sprintf("%#v", suite.A), "They should be equal")
+
+    // The output above, pasted back in as source
+    rebuilt := MustMatrix(
+        []float64{1, -2.5},
+        []float64{30, 4},
+    )
+    suite.Equal(suite.A, rebuilt, "They should be equal")
+
+    suite.Equal("BlankMatrix(0, 0)", fmt.Sprintf("%#v", BlankMatrix(0, 0)), "They should be equal")
+    suite.Equal("BlankMatrix(0, 3)", fmt.Sprintf("%#v", BlankMatrix(0, 3)), "The columns should be kept")
+    suite.Equal("MustMatrix(\n\t[]float64{},\n\t[]float64{},\n)", fmt.Sprintf("%#v", BlankMatrix(2, 0)), "The rows should be kept")
+    suite.Equal(
+        "MustMatrix(\n" +
+        "\t[]float64{0.1, 1e+21, math.NaN(), math.Inf(-1), math.Copysign(0, -1)},\n" +
+        ")", fmt.Sprintf("%#v", MustMatrix([]float64{0.1, 1e21, math.NaN(), math.Inf(-1), math.Copysign(0, -1)})), "They should be equal")
+}
+
+func TestFormat(t *testing.T) {
+    suite.Run(t, new(FormatTestSuite))
+}
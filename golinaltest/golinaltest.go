@@ -0,0 +1,114 @@
+// Package golinaltest provides test assertions for golinal
+// matrices. They work with a plain *testing.T and, on failure,
+// report the dimensions or print the actual Matrix with the
+// mismatching entries marked, followed by a list of them.
+package golinaltest
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/gwomark/golinal"
+)
+
+// Mismatching entries listed in a failure message
+const maxListed = 10
+
+// brief: Fails t unless expected and actual are equal within
+//        absTol and relTol, as in golinal.EqualApprox()
+//
+// returns: whether they were equal
+func AssertEqualApprox(t testing.TB, expected, actual *golinal.Matrix, absTol, relTol float64) bool {
+	t.Helper()
+
+	if golinal.EqualApprox(expected, actual, absTol, relTol) {
+		return true
+	}
+	t.Errorf("Matrices not equal within absTol %g, relTol %g\n%s", absTol, relTol,
+		Diff(expected, actual, func(x, y float64) bool {
+			return golinal.ScalarEqualApprox(x, y, absTol, relTol)
+		}))
+
+	return false
+}
+
+// brief: Fails t unless expected and actual are equal within
+//        ulps units in the last place, as in golinal.EqualULP()
+//
+// returns: whether they were equal
+func AssertEqualULP(t testing.TB, expected, actual *golinal.Matrix, ulps uint) bool {
+	t.Helper()
+
+	if golinal.EqualULP(expected, actual, ulps) {
+		return true
+	}
+	t.Errorf("Matrices not equal within %d ULP\n%s", ulps,
+		Diff(expected, actual, func(x, y float64) bool {
+			return golinal.ScalarEqualULP(x, y, ulps)
+		}))
+
+	return false
+}
+
+// brief: Describes how actual differs from expected
+//
+// details: Dimensions are compared first. Otherwise actual is
+//          printed with a '*' after each entry that doesn't
+//          match the one in expected, followed by the first
+//          few mismatches with both values and their difference
+//
+// inputs: match, reports whether two entries are equal enough
+//
+// returns: the description, empty if nothing differs
+func Diff(expected, actual *golinal.Matrix, match func(x, y float64) bool) string {
+	rows, cols := expected.Dims()
+	if r, c := actual.Dims(); r != rows || c != cols {
+		return fmt.Sprintf("Dimensions differ: expected %dx%d, actual %dx%d", rows, cols, r, c)
+	}
+
+	// Render actual, marking mismatches
+	cells := make([][]string, rows)
+	widths := make([]int, cols)
+	var listed []string
+	mismatches := 0
+	for i := 0; i < rows; i++ {
+		cells[i] = make([]string, cols)
+		for j := 0; j < cols; j++ {
+			x, y := expected.At(i, j), actual.At(i, j)
+			cell := fmt.Sprintf("%g ", y)
+			if !match(x, y) {
+				cell = fmt.Sprintf("%g*", y)
+				mismatches++
+				if len(listed) < maxListed {
+					listed = append(listed, fmt.Sprintf("  (%d, %d): expected %g, actual %g, diff %g", i, j, x, y, math.Abs(x-y)))
+				}
+			}
+			cells[i][j] = cell
+			widths[j] = max(widths[j], len(cell))
+		}
+	}
+	if mismatches == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d of %d entries differ, actual:\n", mismatches, rows*cols)
+	for _, row := range cells {
+		b.WriteString("[")
+		for j, cell := range row {
+			if j > 0 {
+				b.WriteString(" ")
+			}
+			b.WriteString(strings.Repeat(" ", widths[j]-len(cell)) + cell)
+		}
+		b.WriteString("]\n")
+	}
+	b.WriteString(strings.Join(listed, "\n"))
+	if mismatches > len(listed) {
+		fmt.Fprintf(&b, "\n  ... and %d more", mismatches-len(listed))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,88 @@
+package golinaltest
+
+import (
+    "github.com/stretchr/testify/suite";
+    "github.com/gwomark/golinal";
+    "fmt";
+    "strings";
+    "testing"
+)
+
+//*************************************
+// Assertion Helper Test Suite
+//*************************************
+
+// recorder captures failures instead of failing the test
+type recorder struct {
+    testing.TB
+
+    failures []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+    r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+type GolinaltestTestSuite struct {
+    suite.Suite
+
+    A *golinal.Matrix
+    rec *recorder
+}
+
+func (suite *GolinaltestTestSuite) SetupTest() {
+    suite.A = golinal.MustMatrix(
+        []float64{1, 2},
+        []float64{3, 4},
+    )
+    suite.rec = &recorder{TB: suite.T()}
+}
+
+func (suite *GolinaltestTestSuite) TestPass() {
+    close := golinal.MustMatrix(
+        []float64{1, 2 + 1e-9},
+        []float64{3, 4},
+    )
+    suite.True(AssertEqualApprox(suite.rec, suite.A, close, 1e-6, 0), "They should be equal")
+    suite.True(AssertEqualULP(suite.rec, suite.A, suite.A, 0), "They should be equal")
+    suite.Empty(suite.rec.failures, "There should be no failure")
+}
+
+func (suite *GolinaltestTestSuite) TestDiff() {
+    off := golinal.MustMatrix(
+        []float64{1, 2.5},
+        []float64{3, 4},
+    )
+    suite.False(AssertEqualApprox(suite.rec, suite.A, off, 1e-6, 1e-6), "They shouldn't be equal")
+    suite.Require().Len(suite.rec.failures, 1, "There should be one failure")
+
+    msg := suite.rec.failures[0]
+    suite.Contains(msg, "1 of 4 entries differ", "The count should be reported")
+    suite.Contains(msg, "[1  2.5*]", "The mismatch should be marked")
+    suite.Contains(msg, "[3    4 ]", "Matching entries should be unmarked")
+    suite.Contains(msg, "(0, 1): expected 2, actual 2.5, diff 0.5", "The mismatch should be listed")
+}
+
+func (suite *GolinaltestTestSuite) TestDiffDims() {
+    suite.False(AssertEqualULP(suite.rec, suite.A, golinal.BlankMatrix(2, 3), 4), "They shouldn't be equal")
+    suite.Require().Len(suite.rec.failures, 1, "There should be one failure")
+    suite.Contains(suite.rec.failures[0], "expected 2x2, actual 2x3", "The dimensions should be reported")
+}
+
+func (suite *GolinaltestTestSuite) TestDiffTruncated() {
+    a, b := golinal.BlankMatrix(4, 4), golinal.BlankMatrix(4, 4)
+    for i := 0; i < 4; i++ {
+        for j := 0; j < 4; j++ {
+            b.Set(i, j, 1)
+        }
+    }
+    diff := Diff(a, b, func(x, y float64) bool { return x == y })
+    suite.Equal(maxListed, strings.Count(diff, "expected 0, actual 1"), "Only the first mismatches should be listed")
+    suite.Contains(diff, "... and 6 more", "The rest should be counted")
+}
+
+func TestGolinaltest(t *testing.T) {
+    suite.Run(t, new(GolinaltestTestSuite))
+}
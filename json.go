@@ -0,0 +1,221 @@
+package golinal
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// FlatJSON Struct Definition
+//
+// details: Wraps a Matrix so it marshals with its data as one
+//          flat row-major array instead of an array of rows,
+//          e.g. json.Marshal(FlatJSON{m}). Unmarshalling a
+//          Matrix accepts either layout.
+type FlatJSON struct {
+	*Matrix
+}
+
+// jsonMatrix is the shape of a Matrix in JSON, data being
+// either [][]jsonFloat or []jsonFloat
+type jsonMatrix struct {
+	Rows *int            `json:"rows"`
+	Cols *int            `json:"cols"`
+	Data json.RawMessage `json:"data"`
+}
+
+// jsonFloat is a float64 that JSON can carry NaN and
+// infinities in, as the strings "NaN", "+Inf" and "-Inf"
+type jsonFloat float64
+
+// brief: Encodes a Matrix as JSON
+//
+// details: {"rows":2,"cols":2,"data":[[1,2],[3,4]]}. Implements
+//          json.Marshaler. NaN and infinite entries, which JSON
+//          numbers can't hold, are written as the strings "NaN",
+//          "+Inf" and "-Inf". See FlatJSON for flat data.
+//
+// note: a value receiver, so a Matrix value or value field
+//       marshals the same as a *Matrix
+//
+// returns: the encoding, the error is always nil
+func (m Matrix) MarshalJSON() ([]byte, error) {
+	return m.appendJSON(nil, false), nil
+}
+
+// brief: Encodes the wrapped Matrix as JSON with flat data
+//
+// details: {"rows":2,"cols":2,"data":[1,2,3,4]}
+//
+// returns: the encoding, the error is always nil
+func (f FlatJSON) MarshalJSON() ([]byte, error) {
+	return f.Matrix.appendJSON(nil, true), nil
+}
+
+// brief: Decodes a Matrix from JSON
+//
+// details: Implements json.Unmarshaler. data may be an
+//          array of rows or a flat row-major array, and its
+//          entries numbers or "NaN", "Inf", "+Inf" or "-Inf".
+//          m is replaced.
+//
+// returns: an error if rows or cols is missing, negative or
+//          too large, the JSON is malformed, or an *ErrShape
+//          if data doesn't match rows and cols
+func (m *Matrix) UnmarshalJSON(data []byte) error {
+	var raw jsonMatrix
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw.Rows == nil || raw.Cols == nil {
+		return errors.New("JSON Matrix needs both rows and cols")
+	}
+	rows, cols := *raw.Rows, *raw.Cols
+	if rows < 0 || cols < 0 {
+		return errors.New("JSON Matrix can't have negative dimensions")
+	}
+
+	if cols != 0 && rows > math.MaxInt/cols {
+		return fmt.Errorf("JSON Matrix of %dx%d is too large", rows, cols)
+	}
+
+	// Nothing is allocated until data is known to match
+	// rows and cols, which come from the client
+	var result *Matrix
+	if isNested(raw.Data) {
+		var entries [][]jsonFloat
+		if err := json.Unmarshal(raw.Data, &entries); err != nil {
+			return err
+		}
+		if len(entries) != rows {
+			return &ErrShape{Op: "unmarshal JSON", Rows: rows, Cols: cols, OtherRows: len(entries), OtherCols: cols}
+		}
+		for _, row := range entries {
+			if len(row) != cols {
+				return &ErrShape{Op: "unmarshal JSON", Rows: rows, Cols: cols, OtherRows: rows, OtherCols: len(row)}
+			}
+		}
+		result = BlankMatrix(rows, cols)
+		for i, row := range entries {
+			for j, v := range row {
+				result.data[i*result.stride+j] = float64(v)
+			}
+		}
+	} else {
+		var entries []jsonFloat
+		if len(raw.Data) > 0 {
+			if err := json.Unmarshal(raw.Data, &entries); err != nil {
+				return err
+			}
+		}
+		if len(entries) != rows*cols {
+			return &ErrShape{Op: "unmarshal JSON", Rows: rows, Cols: cols, OtherRows: len(entries), OtherCols: 1}
+		}
+		result = BlankMatrix(rows, cols)
+		for k, v := range entries {
+			result.data[k] = float64(v)
+		}
+	}
+	*m = *result
+
+	return nil
+}
+
+// brief: Decodes a Matrix from JSON, in either layout
+func (f *FlatJSON) UnmarshalJSON(data []byte) error {
+	if f.Matrix == nil {
+		f.Matrix = new(Matrix)
+	}
+
+	return f.Matrix.UnmarshalJSON(data)
+}
+
+// brief: Appends the JSON encoding of m to buf
+func (m *Matrix) appendJSON(buf []byte, flat bool) []byte {
+	buf = append(buf, `{"rows":`...)
+	buf = strconv.AppendInt(buf, int64(m.numRows), 10)
+	buf = append(buf, `,"cols":`...)
+	buf = strconv.AppendInt(buf, int64(m.numCols), 10)
+	buf = append(buf, `,"data":[`...)
+	for i := 0; i < m.numRows; i++ {
+		if flat {
+			for j, v := range m.rowView(i) {
+				if i > 0 || j > 0 {
+					buf = append(buf, ',')
+				}
+				buf = jsonFloat(v).append(buf)
+			}
+			continue
+		}
+
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		buf = append(buf, '[')
+		for j, v := range m.rowView(i) {
+			if j > 0 {
+				buf = append(buf, ',')
+			}
+			buf = jsonFloat(v).append(buf)
+		}
+		buf = append(buf, ']')
+	}
+
+	return append(buf, "]}"...)
+}
+
+// brief: Reports whether raw is an array of arrays
+func isNested(raw []byte) bool {
+	raw = bytes.TrimLeft(raw, " \t\r\n")
+	if len(raw) == 0 || raw[0] != '[' {
+		return false
+	}
+	raw = bytes.TrimLeft(raw[1:], " \t\r\n")
+
+	return len(raw) > 0 && raw[0] == '['
+}
+
+// brief: Appends the JSON encoding of f to buf
+func (f jsonFloat) append(buf []byte) []byte {
+	v := float64(f)
+	switch {
+	case math.IsNaN(v):
+		return append(buf, `"NaN"`...)
+	case math.IsInf(v, 1):
+		return append(buf, `"+Inf"`...)
+	case math.IsInf(v, -1):
+		return append(buf, `"-Inf"`...)
+	}
+
+	return strconv.AppendFloat(buf, v, 'g', -1, 64)
+}
+
+// brief: Decodes a number, or one of the strings "NaN",
+//        "Inf", "+Inf" and "-Inf"
+func (f *jsonFloat) UnmarshalJSON(data []byte) error {
+	var s string
+	if json.Unmarshal(data, &s) == nil {
+		switch s {
+		case "NaN":
+			*f = jsonFloat(math.NaN())
+		case "Inf", "+Inf":
+			*f = jsonFloat(math.Inf(1))
+		case "-Inf":
+			*f = jsonFloat(math.Inf(-1))
+		default:
+			return errors.New("JSON Matrix entry " + strconv.Quote(s) + " isn't a number, NaN or Inf")
+		}
+		return nil
+	}
+
+	var v float64
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*f = jsonFloat(v)
+
+	return nil
+}
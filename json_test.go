@@ -0,0 +1,127 @@
+package golinal
+
+import (
+    "github.com/stretchr/testify/suite";
+    "encoding/json";
+    "errors";
+    "math";
+    "testing"
+)
+
+//*******************************
+// JSON Test Suite
+//*******************************
+
+type JSONTestSuite struct {
+    suite.Suite
+
+    A *Matrix
+}
+
+func (suite *JSONTestSuite) SetupTest() {
+    suite.A = MustMatrix(
+        []float64{1, -2.5, 0.1},
+        []float64{math.NaN(), math.Inf(1), math.Inf(-1)})
+}
+
+func (suite *JSONTestSuite) TestMarshal() {
+    data, err := json.Marshal(suite.A)
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal(`{"rows":2,"cols":3,"data":[[1,-2.5,0.1],["NaN","+Inf","-Inf"]]}`, string(data), "They should be equal")
+
+    data, err = json.Marshal(FlatJSON{suite.A})
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal(`{"rows":2,"cols":3,"data":[1,-2.5,0.1,"NaN","+Inf","-Inf"]}`, string(data), "They should be equal")
+
+    data, _ = json.Marshal(BlankMatrix(0, 2))
+    suite.Equal(`{"rows":0,"cols":2,"data":[]}`, string(data), "They should be equal")
+
+    // Works as a field too
+    data, _ = json.Marshal(struct {
+        Result *Matrix `json:"result"`
+    }{Identity(2)})
+    suite.Equal(`{"result":{"rows":2,"cols":2,"data":[[1,0],[0,1]]}}`, string(data), "They should be equal")
+
+    // and as a value
+    data, err = json.Marshal(struct {
+        Result Matrix `json:"result"`
+    }{*Identity(2)})
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal(`{"result":{"rows":2,"cols":2,"data":[[1,0],[0,1]]}}`, string(data), "They should be equal")
+    data, _ = json.Marshal(*suite.A)
+    suite.Equal(`{"rows":2,"cols":3,"data":[[1,-2.5,0.1],["NaN","+Inf","-Inf"]]}`, string(data), "They should be equal")
+
+    var nilMatrix *Matrix
+    data, _ = json.Marshal(nilMatrix)
+    suite.Equal(`null`, string(data), "They should be equal")
+}
+
+func (suite *JSONTestSuite) TestRoundTrip() {
+    for _, m := range []*Matrix{RandMatrix, NonsquareMatrix, NonsquareMatrix2, BlankMatrix(0, 0), BlankMatrix(3, 0)} {
+        for _, marshal := range []func(*Matrix) ([]byte, error){
+            func(m *Matrix) ([]byte, error) { return json.Marshal(m) },
+            func(m *Matrix) ([]byte, error) { return json.Marshal(FlatJSON{m}) },
+        } {
+            data, err := marshal(m)
+            suite.Equal(nil, err, "There should be no error")
+
+            decoded := new(Matrix)
+            suite.Equal(nil, json.Unmarshal(data, decoded), "There should be no error")
+            suite.Equal(m, decoded, "They should be equal")
+
+            var flat FlatJSON
+            suite.Equal(nil, json.Unmarshal(data, &flat), "There should be no error")
+            suite.Equal(m, flat.Matrix, "They should be equal")
+        }
+    }
+
+    data, _ := json.Marshal(suite.A)
+    decoded := new(Matrix)
+    suite.Equal(nil, json.Unmarshal(data, decoded), "There should be no error")
+    suite.True(math.IsNaN(decoded.At(1, 0)), "NaN should survive")
+    suite.True(math.IsInf(decoded.At(1, 1), 1), "+Inf should survive")
+    suite.True(math.IsInf(decoded.At(1, 2), -1), "-Inf should survive")
+}
+
+func (suite *JSONTestSuite) TestUnmarshalErrors() {
+    shape := []string{
+        `{"rows":2,"cols":2,"data":[[1,2]]}`,
+        `{"rows":2,"cols":2,"data":[[1,2],[3]]}`,
+        `{"rows":2,"cols":2,"data":[1,2,3]}`,
+        `{"rows":1,"cols":1,"data":[]}`,
+        `{"rows":1,"cols":1}`,
+    }
+    for _, input := range shape {
+        err := json.Unmarshal([]byte(input), new(Matrix))
+        suite.True(errors.Is(err, &ErrShape{}), "Error should match ErrShape for %s", input)
+    }
+
+    invalid := []string{
+        `{"cols":2,"data":[1,2]}`,
+        `{"rows":-1,"cols":2,"data":[]}`,
+        `{"rows":1,"cols":1,"data":["one"]}`,
+        `{"rows":1,"cols":1,"data":[true]}`,
+        `{"rows":1,"cols":2,"data":[[1,2]`,
+        `[1,2]`,
+
+        // Dimensions from an untrusted client that overflow
+        // or don't fit in memory
+        `{"rows":4611686018427387904,"cols":4,"data":[]}`,
+        `{"rows":1e11,"cols":1e11,"data":[]}`,
+        `{"rows":100000000000,"cols":100000000000,"data":[]}`,
+        `{"rows":100000000000,"cols":100000000000,"data":[[1]]}`,
+    }
+    for _, input := range invalid {
+        m := RandMatrix.copy()
+        suite.NotEqual(nil, json.Unmarshal([]byte(input), m), "There should be an error for %s", input)
+        suite.Equal(RandMatrix, m, "Matrix should be unchanged")
+    }
+
+    m := new(Matrix)
+    suite.Equal(nil, json.Unmarshal([]byte(`{"rows":1,"cols":3,"data":["Inf",2,"NaN"]}`), m), "There should be no error")
+    suite.True(math.IsInf(m.At(0, 0), 1), "Inf should read as +Inf")
+}
+
+func TestJSON(t *testing.T) {
+    suite.Run(t, new(JSONTestSuite))
+}
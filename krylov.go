@@ -0,0 +1,454 @@
+package golinal
+
+import (
+	"errors"
+	"math"
+)
+
+// Operator is anything that can multiply a Vector, which is
+// all the Krylov solvers need to know about A. *Matrix, *CSR
+// and *CSC are all Operators.
+type Operator interface {
+	Dims() (int, int)
+	MulVec(v *Vector) (*Vector, error)
+}
+
+// Default tolerance on ||b - Ax|| / ||b||
+const defaultSolverTol = 1e-10
+
+// Default number of GMRES steps between restarts
+const defaultRestart = 30
+
+// SolverOptions Struct Definition
+//
+// details: Settings shared by CG(), GMRES() and BiCGSTAB().
+//          Zero values pick the defaults: Tol 1e-10, MaxIter
+//          10n, Restart min(n, 30), no preconditioner and a
+//          zero starting guess.
+type SolverOptions struct {
+	// Stop once ||b - Ax|| <= Tol * ||b||
+	Tol float64
+
+	// Iterations allowed, one or two products with A each
+	MaxIter int
+
+	// GMRES only, Krylov subspace size before restarting
+	Restart int
+
+	// Applied as M^-1, on the left for CG and
+	// on the right for GMRES and BiCGSTAB
+	Precond Preconditioner
+
+	// Starting guess, left untouched
+	X0 *Vector
+}
+
+// SolverResult Struct Definition
+//
+// details: The outcome of an iterative solve. History holds
+//          the relative residual ||b - Ax|| / ||b|| before the
+//          first iteration and after each one, so it has
+//          Iterations+1 entries.
+type SolverResult struct {
+	X          *Vector
+	Iterations int
+	Residual   float64
+	History    []float64
+	Converged  bool
+}
+
+// brief: Solves Ax = b with the conjugate gradient method
+//
+// details: A must be symmetric positive definite, as must
+//          the preconditioner if there is one. Each iteration
+//          costs one product with A and one preconditioner solve.
+//
+// inputs: opts, may be nil for the defaults
+//
+// returns: the result, and ErrNotConverged if MaxIter was
+//          reached or a *ErrNotPositiveDefinite if A turned
+//          out not to be positive definite. The last iterate
+//          is returned along with either error.
+func CG(a Operator, b *Vector, opts *SolverOptions) (*SolverResult, error) {
+	s, err := newKrylov(a, b, opts)
+	if err != nil {
+		return nil, err
+	}
+	if s.done() {
+		return s.result, nil
+	}
+
+	r := s.r
+	z, err := s.precondition(r)
+	if err != nil {
+		return s.result, err
+	}
+	p := append([]float64(nil), z...)
+	rz := dot(r, z)
+
+	for k := 0; k < s.maxIter; k++ {
+		Ap, err := s.apply(p)
+		if err != nil {
+			return s.result, err
+		}
+		pAp := dot(p, Ap)
+		if !(pAp > 0) {
+			return s.result, &ErrNotPositiveDefinite{Index: k, Pivot: pAp}
+		}
+
+		alpha := rz / pAp
+		axpy(alpha, p, s.x)
+		axpy(-alpha, Ap, r)
+		if s.step(nrm2(r)) {
+			return s.result, nil
+		}
+
+		z, err = s.precondition(r)
+		if err != nil {
+			return s.result, err
+		}
+		rzNext := dot(r, z)
+		beta := rzNext / rz
+		rz = rzNext
+
+		// p = z + beta p
+		scal(beta, p)
+		axpy(1, z, p)
+	}
+
+	return s.result, ErrNotConverged
+}
+
+// brief: Solves Ax = b with restarted GMRES
+//
+// details: GMRES(m) with modified Gram-Schmidt Arnoldi and
+//          Givens rotations, right preconditioned so the
+//          residual tracked is that of the original system.
+//          Works for any nonsingular A. Memory is O(nm).
+//
+// inputs: opts, may be nil for the defaults
+//
+// returns: the result, and ErrNotConverged if MaxIter was
+//          reached. The last iterate is returned either way.
+func GMRES(a Operator, b *Vector, opts *SolverOptions) (*SolverResult, error) {
+	s, err := newKrylov(a, b, opts)
+	if err != nil {
+		return nil, err
+	}
+	if s.done() {
+		return s.result, nil
+	}
+
+	n := len(s.x)
+	m := defaultRestart
+	if opts != nil && opts.Restart > 0 {
+		m = opts.Restart
+	}
+	m = min(m, n)
+
+	V := make([][]float64, m+1)
+	Z := make([][]float64, m)
+	H := BlankMatrix(m+1, m)
+	cs := make([]float64, m)
+	sn := make([]float64, m)
+	g := make([]float64, m+1)
+
+	for s.result.Iterations < s.maxIter {
+		beta := nrm2(s.r)
+		V[0] = append(V[0][:0], s.r...)
+		scal(1/beta, V[0])
+		for i := range g {
+			g[i] = 0
+		}
+		g[0] = beta
+
+		// Arnoldi, building an orthonormal basis of the
+		// Krylov subspace one column at a time
+		j := 0
+		for j < m && s.result.Iterations < s.maxIter {
+			Z[j], err = s.precondition(V[j])
+			if err != nil {
+				return s.result, err
+			}
+			w, err := s.apply(Z[j])
+			if err != nil {
+				return s.result, err
+			}
+			for i := 0; i <= j; i++ {
+				h := dot(w, V[i])
+				H.data[i*H.stride+j] = h
+				axpy(-h, V[i], w)
+			}
+			next := nrm2(w)
+			H.data[(j+1)*H.stride+j] = next
+
+			// Bring column j of H to upper triangular form
+			for i := 0; i < j; i++ {
+				hi, hk := H.At(i, j), H.At(i+1, j)
+				H.data[i*H.stride+j] = cs[i]*hi + sn[i]*hk
+				H.data[(i+1)*H.stride+j] = -sn[i]*hi + cs[i]*hk
+			}
+			hj := H.At(j, j)
+			r := math.Hypot(hj, next)
+			cs[j], sn[j] = hj/r, next/r
+			H.data[j*H.stride+j] = r
+			H.data[(j+1)*H.stride+j] = 0
+			g[j+1] = -sn[j] * g[j]
+			g[j] *= cs[j]
+
+			j++
+			converged := s.stepEstimate(math.Abs(g[j]))
+			if converged || next == 0 {
+				break
+			}
+			V[j] = append(V[j][:0], w...)
+			scal(1/next, V[j])
+		}
+
+		// Minimize over the subspace, x = x + Z y
+		// with H y = g by backwards substitution
+		y := g[:j]
+		for i := j - 1; i >= 0; i-- {
+			row := H.rowView(i)
+			y[i] = (y[i] - dot(row[i+1:j], y[i+1:])) / row[i]
+		}
+		for i, yi := range y {
+			axpy(yi, Z[i], s.x)
+		}
+
+		// Recompute the true residual, the estimate
+		// drifts from it in floating point
+		if err := s.refresh(); err != nil {
+			return s.result, err
+		}
+		s.result.History[s.result.Iterations] = s.result.Residual
+		if s.result.Converged {
+			return s.result, nil
+		}
+	}
+
+	return s.result, ErrNotConverged
+}
+
+// brief: Solves Ax = b with BiCGSTAB
+//
+// details: van der Vorst's stabilized biconjugate gradient,
+//          right preconditioned. Works for nonsymmetric A with
+//          short recurrences, two products with A per iteration.
+//
+// inputs: opts, may be nil for the defaults
+//
+// returns: the result, and ErrNotConverged if MaxIter was
+//          reached or an error if the method broke down. The
+//          last iterate is returned either way.
+func BiCGSTAB(a Operator, b *Vector, opts *SolverOptions) (*SolverResult, error) {
+	s, err := newKrylov(a, b, opts)
+	if err != nil {
+		return nil, err
+	}
+	if s.done() {
+		return s.result, nil
+	}
+
+	n := len(s.x)
+	r := s.r
+	rHat := append([]float64(nil), r...)
+	p := make([]float64, n)
+	v := make([]float64, n)
+	rho, alpha, omega := 1.0, 1.0, 1.0
+
+	for k := 0; k < s.maxIter; k++ {
+		rhoNext := dot(rHat, r)
+		if rhoNext == 0 {
+			return s.result, errors.New("BiCGSTAB broke down: rho is zero")
+		}
+
+		// p = r + beta (p - omega v)
+		beta := (rhoNext / rho) * (alpha / omega)
+		axpy(-omega, v, p)
+		scal(beta, p)
+		axpy(1, r, p)
+		rho = rhoNext
+
+		pHat, err := s.precondition(p)
+		if err != nil {
+			return s.result, err
+		}
+		if v, err = s.apply(pHat); err != nil {
+			return s.result, err
+		}
+		alpha = rho / dot(rHat, v)
+
+		// Half step, r becomes s = r - alpha v
+		axpy(alpha, pHat, s.x)
+		axpy(-alpha, v, r)
+		if nrm2(r) <= s.tol {
+			s.step(nrm2(r))
+			return s.result, nil
+		}
+
+		sHat, err := s.precondition(r)
+		if err != nil {
+			return s.result, err
+		}
+		t, err := s.apply(sHat)
+		if err != nil {
+			return s.result, err
+		}
+		tt := dot(t, t)
+		if tt == 0 {
+			return s.result, errors.New("BiCGSTAB broke down: omega is zero")
+		}
+		omega = dot(t, r) / tt
+
+		axpy(omega, sHat, s.x)
+		axpy(-omega, t, r)
+		if s.step(nrm2(r)) {
+			return s.result, nil
+		}
+		if omega == 0 {
+			return s.result, errors.New("BiCGSTAB broke down: omega is zero")
+		}
+	}
+
+	return s.result, ErrNotConverged
+}
+
+///////////////////////////////
+//         HELPER            //
+//         FUNCTIONS         //
+///////////////////////////////
+
+// krylov holds the state shared by the solvers
+type krylov struct {
+	a       Operator
+	b       []float64
+	precond Preconditioner
+	maxIter int
+
+	// Absolute tolerance Tol * ||b|| and ||b||
+	tol   float64
+	bNorm float64
+
+	x, r   []float64
+	result *SolverResult
+}
+
+// brief: Validates the arguments and sets up x_0 and r_0
+//
+// returns: the solver state, with result filled in, or an
+//          ErrNotSquare or *ErrShape
+func newKrylov(a Operator, b *Vector, opts *SolverOptions) (*krylov, error) {
+	rows, cols := a.Dims()
+	if rows != cols {
+		return nil, ErrNotSquare
+	}
+	if b.n != rows {
+		return nil, errShape("solve", rows, cols, b.n, 1)
+	}
+	if opts == nil {
+		opts = &SolverOptions{}
+	}
+
+	s := &krylov{a: a, b: b.elems, precond: opts.Precond, maxIter: opts.MaxIter}
+	if s.maxIter <= 0 {
+		s.maxIter = 10 * rows
+	}
+	tol := opts.Tol
+	if tol <= 0 {
+		tol = defaultSolverTol
+	}
+	s.bNorm = nrm2(b.elems)
+	s.tol = tol * s.bNorm
+
+	s.x = make([]float64, rows)
+	if opts.X0 != nil {
+		if opts.X0.n != rows {
+			return nil, errShape("solve", rows, cols, opts.X0.n, 1)
+		}
+		copy(s.x, opts.X0.elems)
+	}
+	s.result = &SolverResult{X: &Vector{n: rows, elems: s.x}}
+	if err := s.refresh(); err != nil {
+		return nil, err
+	}
+	s.result.History = append(s.result.History, s.result.Residual)
+
+	return s, nil
+}
+
+// brief: Reports whether x_0 already solves the system
+func (s *krylov) done() bool {
+	return s.result.Converged
+}
+
+// brief: Sets r = b - Ax and records its norm without
+//        counting an iteration
+func (s *krylov) refresh() error {
+	Ax, err := s.apply(s.x)
+	if err != nil {
+		return err
+	}
+	s.r = Ax
+	for i, bi := range s.b {
+		s.r[i] = bi - s.r[i]
+	}
+	norm := nrm2(s.r)
+	s.result.Residual = s.relative(norm)
+	s.result.Converged = norm <= s.tol
+
+	return nil
+}
+
+// brief: Records an iteration with residual norm ||r||
+//
+// returns: whether the solver has converged
+func (s *krylov) step(norm float64) bool {
+	s.result.Iterations++
+	s.result.Residual = s.relative(norm)
+	s.result.History = append(s.result.History, s.result.Residual)
+	s.result.Converged = norm <= s.tol
+
+	return s.result.Converged
+}
+
+// brief: Like step(), but for a residual norm that is only
+//        an estimate, so Residual is left to refresh()
+func (s *krylov) stepEstimate(norm float64) bool {
+	s.result.Iterations++
+	s.result.History = append(s.result.History, s.relative(norm))
+
+	return norm <= s.tol
+}
+
+// brief: Scales an absolute residual norm by ||b||
+func (s *krylov) relative(norm float64) float64 {
+	if s.bNorm == 0 {
+		return norm
+	}
+
+	return norm / s.bNorm
+}
+
+// brief: Computes Ax for a slice x
+func (s *krylov) apply(x []float64) ([]float64, error) {
+	Ax, err := s.a.MulVec(&Vector{n: len(x), elems: x})
+	if err != nil {
+		return nil, err
+	}
+
+	return Ax.elems, nil
+}
+
+// brief: Computes M^-1 r, a copy of r without a preconditioner
+func (s *krylov) precondition(r []float64) ([]float64, error) {
+	if s.precond == nil {
+		return append([]float64(nil), r...), nil
+	}
+	z, err := s.precond.Solve(&Vector{n: len(r), elems: r})
+	if err != nil {
+		return nil, err
+	}
+
+	return z.elems, nil
+}
@@ -0,0 +1,178 @@
+package golinal
+
+import (
+    "github.com/stretchr/testify/suite";
+    "errors";
+    "testing"
+)
+
+//*******************************
+// Krylov Solvers Test Suite
+//*******************************
+
+type KrylovTestSuite struct {
+    suite.Suite
+
+    // 2D Poisson on a 10x10 grid, symmetric positive definite
+    Laplacian *CSR
+
+    // Same stencil plus a first-order convection
+    // term, so nonsymmetric
+    Convection *CSR
+
+    B *Vector
+}
+
+// brief: Assembles the 5-point stencil on a k x k grid, with
+// convection c along x
+func stencil(k int, c float64) *CSR {
+    n := k * k
+    coo := NewCOO(n, n)
+    for i := 0; i < k; i++ {
+        for j := 0; j < k; j++ {
+            row := i*k + j
+            coo.Append(row, row, 4)
+            if i > 0 {
+                coo.Append(row, row-k, -1)
+            }
+            if i < k-1 {
+                coo.Append(row, row+k, -1)
+            }
+            if j > 0 {
+                coo.Append(row, row-1, -1-c)
+            }
+            if j < k-1 {
+                coo.Append(row, row+1, -1+c)
+            }
+        }
+    }
+
+    return coo.ToCSR()
+}
+
+func (suite *KrylovTestSuite) SetupTest() {
+    suite.Laplacian = stencil(10, 0)
+    suite.Convection = stencil(10, 0.5)
+
+    suite.B = BlankVector(100)
+    for i := range suite.B.elems {
+        suite.B.elems[i] = float64(i%7) - 3
+    }
+}
+
+// brief: Checks a result against the LU solution of A
+func (suite *KrylovTestSuite) checkSolution(A *CSR, result *SolverResult, err error) {
+    suite.Equal(nil, err, "There should be no error")
+    suite.True(result.Converged, "Solver should converge")
+    suite.Equal(result.Iterations+1, len(result.History), "They should be equal")
+    suite.LessOrEqual(result.Residual, 1e-10)
+    suite.Equal(result.Residual, result.History[len(result.History)-1], "They should be equal")
+
+    lu, _ := A.ToDense().LUP()
+    expected, _ := lu.Solve(suite.B.elems)
+    suite.InDeltaSlice(expected, result.X.elems, 1e-8)
+}
+
+func (suite *KrylovTestSuite) TestCG() {
+    result, err := CG(suite.Laplacian, suite.B, nil)
+    suite.checkSolution(suite.Laplacian, result, err)
+
+    // CG on an SPD system never lets the residual blow up
+    // and finishes within n steps
+    suite.LessOrEqual(result.Iterations, 100)
+}
+
+func (suite *KrylovTestSuite) TestGMRES() {
+    result, err := GMRES(suite.Convection, suite.B, nil)
+    suite.checkSolution(suite.Convection, result, err)
+
+    // A short restart still gets there
+    result, err = GMRES(suite.Convection, suite.B, &SolverOptions{Restart: 5, MaxIter: 1000})
+    suite.checkSolution(suite.Convection, result, err)
+}
+
+func (suite *KrylovTestSuite) TestBiCGSTAB() {
+    result, err := BiCGSTAB(suite.Convection, suite.B, nil)
+    suite.checkSolution(suite.Convection, result, err)
+}
+
+// Preconditioning pays off in iterations
+func (suite *KrylovTestSuite) TestPreconditioned() {
+    ilu, err := NewILU0(suite.Convection)
+    suite.Equal(nil, err, "There should be no error")
+    jacobi, _ := NewJacobi(suite.Laplacian)
+    iluSym, _ := NewILU0(suite.Laplacian)
+
+    plain, _ := GMRES(suite.Convection, suite.B, nil)
+    result, err := GMRES(suite.Convection, suite.B, &SolverOptions{Precond: ilu})
+    suite.checkSolution(suite.Convection, result, err)
+    suite.Less(result.Iterations, plain.Iterations)
+
+    plain, _ = BiCGSTAB(suite.Convection, suite.B, nil)
+    result, err = BiCGSTAB(suite.Convection, suite.B, &SolverOptions{Precond: ilu})
+    suite.checkSolution(suite.Convection, result, err)
+    suite.Less(result.Iterations, plain.Iterations)
+
+    result, err = CG(suite.Laplacian, suite.B, &SolverOptions{Precond: jacobi})
+    suite.checkSolution(suite.Laplacian, result, err)
+
+    plain, _ = CG(suite.Laplacian, suite.B, nil)
+    result, err = CG(suite.Laplacian, suite.B, &SolverOptions{Precond: iluSym})
+    suite.checkSolution(suite.Laplacian, result, err)
+    suite.Less(result.Iterations, plain.Iterations)
+}
+
+// A dense *Matrix works as an Operator too
+func (suite *KrylovTestSuite) TestDenseOperator() {
+    A := MustMatrix(
+        []float64{4, 1, 0},
+        []float64{1, 3, 1},
+        []float64{0, 1, 2})
+    b := NewVector(1, 2, 3)
+    x, _ := A.Gauss(b.elems)
+
+    for _, solve := range []func(Operator, *Vector, *SolverOptions) (*SolverResult, error){CG, GMRES, BiCGSTAB} {
+        result, err := solve(A, b, nil)
+        suite.Equal(nil, err, "There should be no error")
+        suite.InDeltaSlice(x, result.X.elems, 1e-9)
+    }
+}
+
+func (suite *KrylovTestSuite) TestOptionsAndErrors() {
+    // Starting from the solution takes no iterations
+    exact, _ := CG(suite.Laplacian, suite.B, nil)
+    result, err := CG(suite.Laplacian, suite.B, &SolverOptions{X0: exact.X})
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal(0, result.Iterations, "They should be equal")
+    suite.Equal(1, len(result.History), "They should be equal")
+
+    // A zero right hand side is solved by zero
+    result, err = GMRES(suite.Laplacian, BlankVector(100), nil)
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal(BlankVector(100), result.X, "They should be equal")
+
+    // Running out of iterations still hands back the iterate
+    result, err = BiCGSTAB(suite.Convection, suite.B, &SolverOptions{MaxIter: 2})
+    suite.True(errors.Is(err, ErrNotConverged), "Error should be ErrNotConverged")
+    suite.False(result.Converged, "Solver shouldn't converge")
+    suite.Equal(2, result.Iterations, "They should be equal")
+    suite.Less(result.Residual, result.History[0])
+
+    result, err = GMRES(suite.Convection, suite.B, &SolverOptions{MaxIter: 3, Tol: 1e-14})
+    suite.True(errors.Is(err, ErrNotConverged), "Error should be ErrNotConverged")
+    suite.Equal(3, result.Iterations, "They should be equal")
+
+    var npd *ErrNotPositiveDefinite
+    indefinite := MustMatrix([]float64{1, 0}, []float64{0, -1})
+    _, err = CG(indefinite, NewVector(1, 1), nil)
+    suite.True(errors.As(err, &npd), "Error should match ErrNotPositiveDefinite")
+
+    _, err = CG(suite.Laplacian, NewVector(1, 2), nil)
+    suite.True(errors.Is(err, &ErrShape{}), "Error should match ErrShape")
+    _, err = GMRES(NonsquareMatrix, NewVector(1, 2), nil)
+    suite.True(errors.Is(err, ErrNotSquare), "Error should be ErrNotSquare")
+}
+
+func TestKrylov(t *testing.T) {
+    suite.Run(t, new(KrylovTestSuite))
+}
@@ -0,0 +1,252 @@
+package golinal
+
+import (
+	"math"
+)
+
+// LU Struct Definition
+//
+// details: Holds the result of factorizing a square Matrix A as
+//          PA = LU. L (unit lower triangular, diagonal not stored)
+//          and U are packed together in a single n x n Matrix.
+//          The factorization can be reused to solve for many
+//          right hand sides.
+type LU struct {
+	lu       *Matrix
+	pivot    []int
+	sign     float64
+
+	// First zero pivot, -1 if A is nonsingular
+	zeroPivot int
+
+	// ||A||_1, for Cond1()
+	norm1 float64
+}
+
+// brief: Calculates the LUP decomposition of a Matrix
+//
+// details: Doolittle elimination with partial (row) pivoting.
+//          At step k the row with the largest |a_ik| on or below
+//          the diagonal is swapped in before eliminating, so a zero
+//          or tiny pivot appearing mid-elimination is avoided
+//          whenever possible. The receiver is not modified.
+//          O(n^3)
+//
+// note: a singular Matrix still factorizes, but the resulting
+//       LU reports IsSingular() and refuses to Solve
+//
+// returns: the factorization of m, or ErrNotSquare
+func (m *Matrix) LUP() (*LU, error) {
+
+	// No LUP if Matrix isn't square
+	if !m.IsSquare() {
+		return nil, ErrNotSquare
+	}
+
+	a := m.copy()
+	pivot, sign, zeroPivot := factorLU(a.AsDense())
+
+	return &LU{lu: a, pivot: pivot, sign: sign, zeroPivot: zeroPivot, norm1: m.Norm(NormOne)}, nil
+}
+
+// brief: Gets the unit lower triangular factor
+//
+// returns: L as a new Matrix
+func (f *LU) L() *Matrix {
+	n := f.lu.numRows
+	L := BlankMatrix(n, n)
+	for i := 0; i < n; i++ {
+		row := L.rowView(i)
+		copy(row[:i], f.lu.rowView(i)[:i])
+		row[i] = 1
+	}
+
+	return L
+}
+
+// brief: Gets the upper triangular factor
+//
+// returns: U as a new Matrix
+func (f *LU) U() *Matrix {
+	n := f.lu.numRows
+	U := BlankMatrix(n, n)
+	for i := 0; i < n; i++ {
+		copy(U.rowView(i)[i:], f.lu.rowView(i)[i:])
+	}
+
+	return U
+}
+
+// brief: Gets the row permutation Matrix
+//
+// returns: P as a new Matrix such that PA = LU
+func (f *LU) P() *Matrix {
+	n := len(f.pivot)
+	P := BlankMatrix(n, n)
+	for i, p := range f.pivot {
+		P.data[i*P.stride+p] = 1
+	}
+
+	return P
+}
+
+// brief: Gets the pivot vector
+//
+// details: row i of PA is row Pivot()[i] of A
+//
+// returns: a copy of the pivot indices
+func (f *LU) Pivot() []int {
+	pivot := make([]int, len(f.pivot))
+	copy(pivot, f.pivot)
+
+	return pivot
+}
+
+// brief: Gets the sign of the row permutation
+//
+// returns: +1 for an even number of row swaps, -1 otherwise
+func (f *LU) Sign() float64 {
+	return f.sign
+}
+
+// brief: Reports whether a zero pivot was met
+//
+// returns: true if the factorized Matrix is singular
+func (f *LU) IsSingular() bool {
+	return f.zeroPivot >= 0
+}
+
+// brief: Solves Ax = b using the factorization
+//
+// inputs: b a slice of floats of length n
+//
+// details: forward substitution with L followed by
+//          backward substitution with U, O(n^2)
+//
+// returns: x, an *ErrSingular if A is singular or an
+//          *ErrShape if b has the wrong length
+func (f *LU) Solve(b []float64) ([]float64, error) {
+	n := f.lu.numRows
+	if len(b) != n {
+		return nil, errShape("solve", n, n, len(b), 1)
+	}
+	if f.IsSingular() {
+		return nil, &ErrSingular{Index: f.zeroPivot}
+	}
+
+	x := make([]float64, n)
+	for i, p := range f.pivot {
+		x[i] = b[p]
+	}
+	f.solveInPlace(x)
+
+	return x, nil
+}
+
+// brief: Solves Ax = b using the factorization
+//
+// returns: x as a Vector, or an error if A is singular
+//          or b has the wrong length
+func (f *LU) SolveVec(b *Vector) (*Vector, error) {
+	x, err := f.Solve(b.elems)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Vector{n: len(x), elems: x}, nil
+}
+
+// brief: Solves AX = B using the factorization
+//
+// inputs: B a Matrix with n rows
+//
+// returns: X, an *ErrSingular if A is singular or an
+//          *ErrShape if B has the wrong number of rows
+func (f *LU) SolveMatrix(B *Matrix) (*Matrix, error) {
+	n := f.lu.numRows
+	if B.numRows != n {
+		return nil, errShape("solve", n, n, B.numRows, B.numCols)
+	}
+	if f.IsSingular() {
+		return nil, &ErrSingular{Index: f.zeroPivot}
+	}
+
+	X := BlankMatrix(n, B.numCols)
+	col := make([]float64, n)
+	for j := 0; j < B.numCols; j++ {
+		for i, p := range f.pivot {
+			col[i] = B.At(p, j)
+		}
+		f.solveInPlace(col)
+		for i := 0; i < n; i++ {
+			X.data[i*X.stride+j] = col[i]
+		}
+	}
+
+	return X, nil
+}
+
+// brief: Calculates the determinant of the factorized Matrix
+//
+// details: det(A) = sign(P) * u_11 * ... * u_nn, O(n)
+//
+// returns: the determinant, 0 if A is singular
+func (f *LU) Det() float64 {
+	det := f.sign
+	for i := 0; i < f.lu.numRows; i++ {
+		det *= f.lu.At(i, i)
+	}
+
+	return det
+}
+
+// brief: Calculates the log of the absolute value of the determinant
+//
+// details: Useful when Det() would overflow or underflow
+//
+// returns: log|det(A)| and the sign of det(A),
+//          -Inf and 0 if A is singular
+func (f *LU) LogDet() (float64, float64) {
+	if f.IsSingular() {
+		return math.Inf(-1), 0
+	}
+
+	logDet := 0.0
+	sign := f.sign
+	for i := 0; i < f.lu.numRows; i++ {
+		u := f.lu.At(i, i)
+		if u < 0 {
+			sign = -sign
+		}
+		logDet += math.Log(math.Abs(u))
+	}
+
+	return logDet, sign
+}
+
+// brief: Calculates the inverse of the factorized Matrix
+//
+// details: Solves AX = I, O(n^3)
+//
+// returns: the inverse of A, or an *ErrSingular if A is singular
+func (f *LU) Inverse() (*Matrix, error) {
+	return f.SolveMatrix(Identity(f.lu.numRows))
+}
+
+// brief: Overwrites x, which must already be permuted by P,
+//        with the solution of LUx = x
+func (f *LU) solveInPlace(x []float64) {
+	n := f.lu.numRows
+
+	// Solve Ly = Pb
+	for i := 1; i < n; i++ {
+		x[i] -= dot(f.lu.rowView(i)[:i], x)
+	}
+
+	// Solve Ux = y
+	// Perform backwards substitution
+	for i := n - 1; i >= 0; i-- {
+		row := f.lu.rowView(i)
+		x[i] = (x[i] - dot(row[i+1:], x[i+1:])) / row[i]
+	}
+}
@@ -1,60 +1,98 @@
 package golinal
 
 import (
-	"errors";
-	"math";
 	"fmt";
-	"github.com/gonum/Matrix/mat64";
+	"math";
 )
 
 // Matrix Struct Definition
 //
-type Matrix struct {
-	numRows, numCols int
-	elems [][]float64
-}
+// details: Entries are stored row-major in a single 
+// contiguous slice. Entry (i, j) lives at data[i*stride + j],
+// with stride >= numCols so a Matrix can share storage 
+// with a larger one. A Matrix is a Dense[float64] with 
+// the float64 API on top, AsDense() converts without 
+// copying. It is a defined type rather than an alias as 
+// Go can't declare methods on an instantiated generic type
+//
+type Matrix Dense[float64]
 
 // brief: Parameterized constructor that takes slice 
 // of slices of floats
 //
 // details: Allows us to create a Matrix with only 
-// providing slices and not specifying columns or rows
+// providing slices and not specifying columns or rows.
+// The entries are copied, so the slices can be reused
+//
+// returns: a pointer to a Matrix, or an error if the 
+//          rows don't all have the same length
+func NewMatrix(slices... []float64) (*Matrix, error) {
+	if len(slices) == 0 {
+		return BlankMatrix(0, 0), nil
+	}
+
+	cols := len(slices[0])
+	for i, row := range slices {
+		if len(row) != cols {
+			return nil, fmt.Errorf("Row %d has %d columns, expected %d", i, len(row), cols)
+		}
+	}
+
+	m := BlankMatrix(len(slices), cols)
+	for i, row := range slices {
+		copy(m.rowView(i), row)
+	}
+
+	return m, nil
+}
+
+// brief: Like NewMatrix, but panics on ragged rows
 //
-// note: It is on the user to make sure that all columns have 
-// the same length, otherwise the Matrix will break
+// details: Meant for Matrix literals in variable 
+// declarations and tests, where the shape is known
+// to be valid
 //
 // returns: a pointer to a Matrix
-func NewMatrix(slices... []float64) (*Matrix) {
-	m := new(Matrix)
-	m.numRows = int(len(slices))
-	m.numCols = int(len(slices[0]))
-	m.elems = slices
+func MustMatrix(slices... []float64) *Matrix {
+	m, err := NewMatrix(slices...)
+	if err != nil {
+		panic(err)
+	}
 
 	return m
 }
 
-// brief: Parameterized constructor that takes slice 
-// of slices of floats
+// brief: Constructor that wraps an existing row-major buffer
 //
-// details: Allows us to create a Matrix with only 
-// providing slices and not specifying columns or rows
+// details: No copy is made, so the Matrix and the caller 
+// share storage. Entry (i, j) is data[i*cols + j]
 //
-// note: It is on the user to make sure that all columns have 
-// the same length, otherwise the Matrix will break
+// returns: a pointer to a Matrix, or an error if 
+//          len(data) isn't rows*cols
+func NewMatrixFromData(rows, cols int, data []float64) (*Matrix, error) {
+	if rows < 0 || cols < 0 || len(data) != rows*cols {
+		return nil, fmt.Errorf("Data of length %d can't hold a %dx%d Matrix", len(data), rows, cols)
+	}
+
+	m := new(Matrix)
+	m.numRows = rows
+	m.numCols = cols
+	m.stride = cols
+	m.data = data
+
+	return m, nil
+}
+
+// brief: Constructor for a zero Matrix
 //
-// returns: a pointer to a Matrix
+// returns: a pointer to a rows x cols Matrix
 func BlankMatrix(rows, cols int) (*Matrix) {
 
 	m := new(Matrix)
 	m.numRows = rows
 	m.numCols = cols
-
-	// make empty slice of slices
-	slices := make([][]float64, rows)
-	for i:= range slices {
-		slices[i] = make([]float64, cols)
-	}
-	m.elems = slices
+	m.stride = cols
+	m.data = make([]float64, rows*cols)
 
 	return m
 }
@@ -66,7 +104,7 @@ func Identity(n int) (*Matrix) {
 
 	m := BlankMatrix(n, n)
 	for i := 0; i < n; i++ {
-		m.elems[i][i] = 1
+		m.data[i*m.stride+i] = 1
 	}
 
 	return m
@@ -87,7 +125,42 @@ func (m Matrix) Dims() (int, int) {
 // returns: A_ij for a row i and a row j
 func (m Matrix) At(row, col int) (float64) {
 
-	return m.elems[row][col]
+	return m.data[row*m.stride+col]
+}
+
+// brief: Set the row,col'th entry of a Matrix
+//
+// note: it is undefined behavior to use invalid indices with Set()
+func (m *Matrix) Set(row, col int, x float64) {
+
+	m.data[row*m.stride+col] = x
+}
+
+// brief: Get the row,col'th entry of a Matrix, checking 
+// the indices first
+//
+// returns: A_ij, or an *ErrIndexOutOfRange if (i, j) 
+//          isn't inside the Matrix
+func (m Matrix) AtChecked(row, col int) (float64, error) {
+	if err := m.checkIndex(row, col); err != nil {
+		return 0, err
+	}
+
+	return m.data[row*m.stride+col], nil
+}
+
+// brief: Set the row,col'th entry of a Matrix, checking 
+// the indices first
+//
+// returns: an *ErrIndexOutOfRange if (i, j) isn't 
+//          inside the Matrix, in which case m is unchanged
+func (m *Matrix) SetChecked(row, col int, x float64) error {
+	if err := m.checkIndex(row, col); err != nil {
+		return err
+	}
+	m.data[row*m.stride+col] = x
+
+	return nil
 }
 
 
@@ -111,17 +184,18 @@ func (m Matrix) NumCols() int {
 // 
 // inputs: a Matrix pointer
 //
-// returns: an error if dimensions of the 
+// details: the sum is stored in m, see Add() for 
+//          a form that leaves m untouched
+//
+// returns: an *ErrShape if dimensions of the 
 //          matrices to be summed aren't equal
 func (m *Matrix) Add(q *Matrix) error {
-	if (m.numRows != q.numRows) || (m.numCols != q.numRows) {
-		return errors.New("Dimensions aren't equal")
+	if (m.numRows != q.numRows) || (m.numCols != q.numCols) {
+		return errShape("add", m.numRows, m.numCols, q.numRows, q.numCols)
 	} else {
-		// Loop through each entry, store sum of entries in m
+		// Loop through each row, store sum of entries in m
 		for i := 0; i < m.numRows; i++ {
-			for j := 0; j < q.numRows; j++ {
-				m.elems[i][j] += q.elems[i][j]
-			}
+			axpy(1, q.rowView(i), m.rowView(i))
 		}
 		return nil
 	}
@@ -133,35 +207,25 @@ func (m *Matrix) Add(q *Matrix) error {
 // inputs: A float 
 func (m *Matrix) Scale(x float64) {
 	for i := 0; i < m.numRows; i++ {
-			for j := 0; j < m.numRows; j++ {
-				m.elems[i][j] *= x
-			}
-		}	
+		scal(x, m.rowView(i))
+	}
 }
 
 
 // brief: Multiplys the Matrix m by the Matrix q
 //
-// details: O(n^3)
+// details: O(n^3), cache-blocked, and split across 
+// goroutines once the product is large enough
 // 
-// returns: product of m and q
+// returns: product of m and q, or an *ErrShape if 
+//          m has a different number of columns than q has rows
 func (m Matrix) Multiply(q *Matrix) (*Matrix, error) {
 
 	if (m.numCols != q.numRows) {
-		return nil,errors.New("Dimensions can't be multiplied")
+		return nil,errShape("multiply", m.numRows, m.numCols, q.numRows, q.numCols)
 	} else {
 		result := BlankMatrix(m.numRows, q.numCols)
-		for i := 0; i < m.numRows; i++ {
-			for j := 0; j < q.numCols; j++ {
-				for k := 0; k < q.numRows; k++ {
-					if i == 0 || j == 0 || k == 0 {
-						fmt.Println(result.elems[i][j])
-					}
-					result.elems[i][j] += m.elems[i][k] * q.elems[k][j]
-				}
-			}
-		}
-
+		multiply(result.AsDense(), m.AsDense(), q.AsDense())
 
 		return result, nil	
 	}
@@ -178,9 +242,7 @@ func(m *Matrix) Transpose() *Matrix {
 
 	for i := 0; i < m.numCols; i++ {
 		for j := 0; j < m.numRows; j++ {
-			fmt.Printf("i= %d\n", i)
-			fmt.Printf("j= %d\n", j)
-			transpose.elems[i][j] = m.elems[j][i]
+			transpose.data[i*transpose.stride+j] = m.data[j*m.stride+i]
 		}
 	}
 
@@ -188,155 +250,73 @@ func(m *Matrix) Transpose() *Matrix {
 }
 
 
-// BROKEN DUE TO LUP DECOMP
-// brief: Calculates transpose of Matrix
+// brief: Calculates the inverse of a Matrix
 //
-// details: Implemented for mat64.Matrix interface
+// details: Uses LU decomposition, O(n^3)
 // 
-// returns: a transposed version of m
+// returns: the inverse of m, or an error if m 
+//          isn't square or is singular. See 
+//          InverseCond() to check it can be trusted
 func(m *Matrix) Inverse() (*Matrix, error) {
-	if !m.IsSqaure(){
-		return nil, errors.New("Matrix is not square")
+	lu, err := m.LUP()
+	if err != nil {
+		return nil, err
 	}
 
-	inverseT := BlankMatrix(m.numRows,m.numRows)
-	identity := Identity(m.numRows)
-
-	for i, _ := range inverseT.elems {
-		r, err := m.Gauss(identity.elems[i])
-		if err != nil {
-			return nil, err
-		}
-
-		fmt.Println(r)
-		inverseT.elems[i] = r
-		
+	inverse, err := lu.Inverse()
+	if err != nil {
+		return nil, err
 	}
 
-	fmt.Println(inverseT.elems)
-
-	return inverseT, nil
+	return inverse, nil
 }
 
-// BROKEN METHOD DUE TO LUP DECMOP
 // brief: Calculates determinant of a Matrix
 //
 // details: Uses LU decomposition, O(n^3), 
 // 
 // returns: a determinant of m
 func (m *Matrix) Determinant() (det float64, err error) {
-	L, U, P, err := m.LUP()
+	lu, err := m.LUP()
 	if err != nil {
 		return 0.0, err
 	}
 
-	det, err = determinant(*L, *U, *P)
-	return
+	return lu.Det(), nil
 }
 
 
-
-// brief: Calculates the LUP decomposition of a Matrix
-//
-// details: Decomposes m in to the product of three matrices:
-//              P: A row permutation Matrix
-//              U: An upper triangular Matrix
-//              L: A lower triangular Matrix
-//          Hence Pm = LU,
-//          O(n^3)
-//
-// returns: a determinant of m
-func (m *Matrix) LUP() (*Matrix, *Matrix, *Matrix, error) {
-	
-	// No LUP if Matrix isn't square
-	if !m.IsSqaure() {
-		return nil, nil, nil, errors.New("LUP requires square Matrix")
-	}
-
-	n := m.numRows
-	L := BlankMatrix(n,n)
-	U := BlankMatrix(n,n)
-	P := m.pivotMatrix()
-	Pm, _ := P.Multiply(m)
-
-	
-
-	for j := 0; j < n; j++ {
-		
-		L.elems[j][j] = 1
-
-		// Populate U using the following formula (compile it in LaTex)
-		// u_{ij} = a_{ij} - \sum_{k=1}^{i-1} u_{kj} l_{ik} 
-		for i := 0; i < j+1; i++ {
-			sum := 0.0
-			for k := 0; k < i; k++ {
-				sum += (U.elems[k][j] * L.elems[i][k])
-			}
-			
-			U.elems[i][j] = Pm.elems[i][j] - sum	
-
-		}
-		
-		
-		// Populate L using the following formula (compile it in LaTex)
-		// l_{ij} = \frac{1}{u_{jj}} (a_{ij} - \sum_{k=1}^{j-1} u_{kj} l_{ik}
-		for i := j; i < n; i++ {
-			sum := 0.0
-
-			for k := 0; k < j; k++ {
-				sum += (U.elems[k][j] * L.elems[i][k])
-			}
-			L.elems[i][j] = (Pm.elems[i][j] - sum) / U.elems[j][j]
-		}
-	}
-
-	return L, U, P, nil
-
-}
-
-
-
-
-// BROKEN DUE TO LUP DECOMP
 // brief: Solves equations of the form
 //       Ax = b
 // where A is a Matrix, and x,b are vectors
 //
 // inputs: b a slice of floats to solve for
 //
-// details: If A is square, LU decomposition is used
+// details: If A is square, LU decomposition is used.
+//          Factorize once with LUP() to solve for 
+//          many right hand sides. If A has more rows 
+//          than columns, the least squares solution is 
+//          found with Householder QR
 //
+// returns: x, or an error if A is singular or b has 
+//          the wrong length. See GaussCond() to check 
+//          x can be trusted
 func (A *Matrix) Gauss(b []float64) ([]float64, error) {
 	
-	
-	L, U, P, err := A.LUP()
-	if err != nil {
-		return nil, err
+	if !A.IsSquare() {
+		return A.QR().SolveLeastSquares(b)
 	}
 
-	return gauss(b, L, U, P)
-
-}
-
-
-// brief: Finds the eigenvalues of a square Matrix m
-//
-// returns: a slice of complex numbers
-func (m *Matrix) Eigenvalues() ([]complex128, error) {
-	if m.IsSqaure() == false {
-		err := errors.New("Matrix should be square")
+	lu, err := A.LUP()
+	if err != nil {
 		return nil, err
 	}
 
-	// Create an Eigen type 
-	var eigen mat64.Eigen
+	return lu.Solve(b)
 
-	// Perform eigenvalue decomposition
-	eigen.Factorize(*m, true)
-	
-	return eigen.Values(nil), nil	
 }
 
+
 ///////////////////////////////
 //         HELPER            //
 //         FUNCTIONS         //  
@@ -344,123 +324,92 @@ func (m *Matrix) Eigenvalues() ([]complex128, error) {
 
 
 
-func (m *Matrix) IsSqaure() bool {
+// Machine epsilon for float64, the gap between 1 and 
+// the next representable number
+const eps = 0x1p-52
+
+// brief: Reports whether a Matrix is square
+//
+// returns: true if m has as many rows as columns
+func (m *Matrix) IsSquare() bool {
 	return (m.numRows == m.numCols)
 }
 
-
-
-// brief: Calculates transpose of Matrix
+// brief: Misspelled form of IsSquare()
 //
-// details: Implemented for mat64.Matrix interface
-// 
-// returns: a transposed version of m
-func (m Matrix) pivotMatrix() *Matrix { 
-
-	dim := m.numRows
+// Deprecated: Use IsSquare instead.
+func (m *Matrix) IsSqaure() bool {
+	return m.IsSquare()
+}
 
-	// Permutation Matrix to return
-	P := Identity(dim)
-	
-	// For each column, find the row below the diagonal
-	// with the highest entry in that column
-	for j, row := range m.elems {
-		currentMax := row[j]
-		maxRow := j
-
-		
-		for i := j; i < dim; i++ {
-			if m.elems[i][j] > currentMax {
-				currentMax = m.elems[i][j]
-				maxRow = i
-			}
-		}
 
-		// If the j'th entry in maxRow is 
-		// not on the diagonal, swap the
-		// corresponding rows in P
-		if maxRow != j {
-			P.elems[j], P.elems[maxRow] = P.elems[maxRow], P.elems[j]
-		}
-	}
 
-	return P
+// brief: Gives direct access to the backing storage
+//
+// details: Entry (i, j) is data[i*stride + j]. Writes to
+// data are reflected in the Matrix, so the buffer can be
+// handed to other libraries without copying
+//
+// returns: the backing slice and the row stride
+func (m *Matrix) RawData() ([]float64, int) {
+	return m.data, m.stride
 }
 
-
-func permDeterminant(m *Matrix) float64 {
-	numberOfSwitches := 0.0
-	
-	for i := 0; i < m.numRows; i++ {
-		if m.elems[i][i] != 1 {
-			numberOfSwitches += 1
-		}
+// brief: Checks that (row, col) lies inside m
+//
+// returns: nil, or an *ErrIndexOutOfRange
+func (m *Matrix) checkIndex(row, col int) error {
+	if row < 0 || row >= m.numRows || col < 0 || col >= m.numCols {
+		return &ErrIndexOutOfRange{Row: row, Col: col, Rows: m.numRows, Cols: m.numCols}
 	}
 
-	return math.Pow(-1.0, (numberOfSwitches / 2.0))
+	return nil
 }
 
-
-func determinant(L, U, P Matrix) (float64, error) {
-	n := L.numRows
-	
-	// det(m) = det(L)det(P^-1)det(U)
-	detL := 1.0
-	detU := 1.0
-	detP := permDeterminant(&P)
-
-	// Multiply diagonals to calculate det(L) and det(U)
-	for i := 0; i < n; i++ {
-		detL = L.elems[i][i] * detL
-	}
-
-	for i := 0; i < n; i++ {
-		detU = U.elems[i][i] * detU
+// brief: Makes a deep, compact copy of a Matrix
+//
+// returns: a pointer to the copy
+func (m *Matrix) copy() *Matrix {
+	c := BlankMatrix(m.numRows, m.numCols)
+	for i := 0; i < m.numRows; i++ {
+		copy(c.rowView(i), m.rowView(i))
 	}
 
-	return (detL * detU * detP), nil
-
+	return c
 }
 
-
-func gauss(b []float64, L *Matrix, U *Matrix, P *Matrix) ([]float64, error) {
-	
-	// Multiply P 
-	Pb, err := P.Multiply(NewMatrix(b).Transpose())
-
-	
-	if err != nil {
-		return nil, err
-	}
-
-	// Solve Ly = Pb
-	y := make([]float64, len(b))
-	for i, row := range L.elems {
-		sum := 0.0
-		for j := 0; j < i; j++ {
-			sum += row[j]*y[j]
+// brief: Reports whether every entry of m is a finite number
+func (m *Matrix) isFinite() bool {
+	for i := 0; i < m.numRows; i++ {
+		for _, v := range m.rowView(i) {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				return false
+			}
 		}
-
-		y[i] = (Pb.elems[i][0] - sum) / row[i]
-	 
 	}
 
-	//fmt.Println(b)
-
-	// Solve Ux = y 
-	// Perform backwards substitution
-	x := make([]float64, len(b))
-	for i := len(b)- 1; i >= 0; i++ {
-		sum := 0.0
-		for j := len(b)-1; j > i; j++ {
-			sum += U.elems[i][j]*x[j]
-		}
+	return true
+}
 
-		x[i] = (y[i] - sum) / U.elems[i][0]
+// brief: Gets every row of the backing storage without copying
+//
+// details: Lets ported algorithms index the Matrix as a[i][j]
+//
+// returns: a slice of row views aliasing the Matrix
+func (m *Matrix) rows() [][]float64 {
+	rows := make([][]float64, m.numRows)
+	for i := range rows {
+		rows[i] = m.rowView(i)
 	}
 
-	fmt.Println(x)
-	return x, nil
+	return rows
+}
+
+// brief: Gets row i of the backing storage without copying
+//
+// returns: a slice of length numCols aliasing the Matrix
+func (m *Matrix) rowView(i int) []float64 {
+	return m.data[i*m.stride : i*m.stride+m.numCols]
 }
 
 // brief: Finds the max entry in a 
@@ -468,32 +417,19 @@ func gauss(b []float64, L *Matrix, U *Matrix, P *Matrix) ([]float64, error) {
 //
 // returns: the max value in the slice and its index
 func Max(s []float64) (float64, int) {
-	n:= len(s)
-	currentMax := s[0]
-	index := 0
-
-	for i := 1; i < n; i++ {
-		if s[i] > currentMax {
-			currentMax = s[i]
-			index = i
-		}
-	} 
+	v := Vector{n: len(s), elems: s}
+	index := v.ArgMax()
 
-	return currentMax, index
+	return s[index], index
 }
 
 // brief: Calculates transpose of Matrix
 //
-// details: Implemented for mat64.Matrix interface
+// details: Shorthand for Transpose()
 // 
 // returns: a transposed version of m
-func (m Matrix) T() mat64.Matrix {
-	transpose := m.Transpose()
-
-
-	return *transpose
-
+func (m *Matrix) T() *Matrix {
+	return m.Transpose()
 }
 
 
-
@@ -2,6 +2,8 @@ package golinal
 
 import (
     "github.com/stretchr/testify/suite";
+    "math";
+    "math/cmplx";
     "testing"
 )
 
@@ -9,12 +11,12 @@ import (
 // Global Matrices
 //****************
 
-var NonsquareMatrix = NewMatrix([]float64{1}, []float64{-7})
-var NonsquareMatrix2 = NewMatrix([]float64{3, 4})
+var NonsquareMatrix = MustMatrix([]float64{1}, []float64{-7})
+var NonsquareMatrix2 = MustMatrix([]float64{3, 4})
 
-var ThreeIdentity = NewMatrix([]float64{1, 0, 0}, []float64{0, 1, 0}, []float64{0, 0, 1})
+var ThreeIdentity = MustMatrix([]float64{1, 0, 0}, []float64{0, 1, 0}, []float64{0, 0, 1})
 
-var RandFourMatrix = NewMatrix (
+var RandFourMatrix = MustMatrix(
         []float64{0.223548, 7.51484, 7.94393, 7.95676}, 
         []float64{9.44692, -2.05097, -3.59421, -7.9301}, 
         []float64{-5.90911, -9.56427, -6.67171, -8.09466}, 
@@ -22,7 +24,7 @@ var RandFourMatrix = NewMatrix (
 
 
 
-var RandMatrix = NewMatrix(
+var RandMatrix = MustMatrix(
         []float64{2.99875, -0.722266, -0.237451, -1.11405, -2.127, 8.88714, -1.65288, -5.27189, -5.92509, -6.02403}, 
         []float64{3.26164, 5.86218, 2.81815, -2.06958, 0.366388, -0.271817, -3.51731, 3.22294, -4.71693, -8.95407}, 
         []float64{6.53936, 0.653704, 5.51595, 8.75519, 4.50956, -2.18589, 1.44052, -7.2319, -6.35739, 9.8645}, 
@@ -39,6 +41,18 @@ var RandMatrix = NewMatrix(
 var nilMatrixP *Matrix
 
 
+// brief: Compares two matrices entry by entry within delta
+func matrixInDelta(s *suite.Suite, expected, actual *Matrix, delta float64) {
+    s.Equal(expected.NumRows(), actual.NumRows(), "They should be equal")
+    s.Equal(expected.NumCols(), actual.NumCols(), "They should be equal")
+    for i := 0; i < expected.NumRows(); i++ {
+        for j := 0; j < expected.NumCols(); j++ {
+            s.InDelta(expected.At(i, j), actual.At(i, j), delta, "Entry (%d, %d)", i, j)
+        }
+    }
+}
+
+
 //************************
 // Constructor Test Suite
 //************************
@@ -54,7 +68,7 @@ type ConstructorsTestSuite struct {
 func (suite *ConstructorsTestSuite) SetupTest() {
     suite.EmptyMatrix = BlankMatrix(2, 3)
 
-    suite.MatrixFromSlices = NewMatrix([]float64{1, 0, 0}, []float64{0, 1, 0}, []float64{0, 0, 1})
+    suite.MatrixFromSlices = MustMatrix([]float64{1, 0, 0}, []float64{0, 1, 0}, []float64{0, 0, 1})
 }
 
 func (suite *ConstructorsTestSuite) TestConstructors() {
@@ -65,6 +79,42 @@ func (suite *ConstructorsTestSuite) TestConstructors() {
     suite.Equal(suite.MatrixFromSlices.NumCols(), 3, "They Should be equal")
 }
 
+// NewMatrix copies its input and rejects ragged rows
+func (suite *ConstructorsTestSuite) TestNewMatrix() {
+    row := []float64{1, 2}
+    m, err := NewMatrix(row, []float64{3, 4})
+    row[0] = 10
+
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal(1.0, m.At(0, 0), "NewMatrix should copy its input")
+    suite.Equal(4.0, m.At(1, 1), "They should be equal")
+
+    ragged, err := NewMatrix([]float64{1, 2}, []float64{3})
+    suite.Equal(nilMatrixP, ragged, "Ragged rows should give no Matrix")
+    suite.NotEqual(nil, err, "There should be an error")
+
+    suite.Panics(func() { MustMatrix([]float64{1}, []float64{2, 3}) })
+}
+
+// A Matrix built from a buffer shares storage with it
+func (suite *ConstructorsTestSuite) TestRawData() {
+    buf := []float64{1, 2, 3, 4, 5, 6}
+    m, err := NewMatrixFromData(2, 3, buf)
+
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal(6.0, m.At(1, 2), "They should be equal")
+
+    buf[4] = -5
+    suite.Equal(-5.0, m.At(1, 1), "Writes to the buffer should show in the Matrix")
+
+    data, stride := m.RawData()
+    suite.Equal(3, stride, "They should be equal")
+    suite.Equal(buf, data, "They should be equal")
+
+    _, err = NewMatrixFromData(2, 2, buf)
+    suite.NotEqual(nil, err, "There should be an error")
+}
+
 
 //********************************
 // Addition of Matrices Test Suite
@@ -96,14 +146,14 @@ func (suite *AdditionTestSuite) SetupTest() {
     suite.DiffDimMatrix2 = BlankMatrix(1, 5)
 
     
-    suite.AddToItselfMatrix = NewMatrix([]float64{1, 0, 0}, []float64{0, 1, 0}, []float64{0, 0, 1})
+    suite.AddToItselfMatrix = MustMatrix([]float64{1, 0, 0}, []float64{0, 1, 0}, []float64{0, 0, 1})
     suite.CopyOfItselfMatrix = ThreeIdentity
-    suite.ResultAddToItself = NewMatrix([]float64{2, 0, 0}, []float64{0, 2, 0}, []float64{0, 0, 2})
+    suite.ResultAddToItself = MustMatrix([]float64{2, 0, 0}, []float64{0, 2, 0}, []float64{0, 0, 2})
 
     
-    suite.SquareMatrix1 = NewMatrix([]float64{1, 6}, []float64{5, -7})
-    suite.SquareMatrix2 = NewMatrix([]float64{1.05, -10}, []float64{-103, 4})
-    suite.ResultMatrix  = NewMatrix([]float64{2.05, -4}, []float64{-98, -3})
+    suite.SquareMatrix1 = MustMatrix([]float64{1, 6}, []float64{5, -7})
+    suite.SquareMatrix2 = MustMatrix([]float64{1.05, -10}, []float64{-103, 4})
+    suite.ResultMatrix  = MustMatrix([]float64{2.05, -4}, []float64{-98, -3})
 }
 
 
@@ -156,10 +206,10 @@ type MultiplicationTestSuite struct {
 
 
 func (suite *MultiplicationTestSuite) SetupTest() {
-    suite.MismatchRowCol1 = NewMatrix([]float64{1, 6, 3}, []float64{5, -7, 3})
+    suite.MismatchRowCol1 = MustMatrix([]float64{1, 6, 3}, []float64{5, -7, 3})
     suite.MismatchRowCol2 = NonsquareMatrix
 
-    suite.SquaredRandMatrix = NewMatrix(
+    suite.SquaredRandMatrix = MustMatrix(
         []float64{11.7719, 129.774, 21.9211, -153.57, 33.6721, 95.1895, 65.75, -102.2, 240.369, 90.2049}, 
         []float64{55.3243, 93.0136, 31.7845, 3.5077, -63.0053, 39.0486, 87.8007, -105.999, 48.3232, -17.1332}, 
         []float64{-123.397, 31.8222, -152.806, 154.054, 140.097, -139.273, -99.3154, 62.8844, -122.848, 47.4363}, 
@@ -191,7 +241,7 @@ func (suite *MultiplicationTestSuite) TestMultiplication() {
     suite.Equal(RandMatrix, mult4, "They should be equal")
     suite.Equal(err4, nil, "They should be equal")
 
-    suite.Equal(suite.SquaredRandMatrix, mult5, "They should be equal")
+    suite.True(EqualApprox(suite.SquaredRandMatrix, mult5, 0, 1e-5), "They should be equal to 6 significant digits")
     suite.Equal(err5, nil, "They should be equal")
 }
 
@@ -216,45 +266,45 @@ type LUPDecompTestSuite struct {
 }
 
 func (suite *LUPDecompTestSuite) SetupTest() {
-    suite.ThreeMatrix = NewMatrix(
+    suite.ThreeMatrix = MustMatrix(
         []float64{1,  3,  5},
         []float64{2,  4,  7},
         []float64{1,  1,  0})
 
-    suite.LThree = NewMatrix(
+    suite.LThree = MustMatrix(
         []float64{1.00000,  0.00000,  0.00000},
         []float64{0.50000,  1.00000,  0.00000},
         []float64{0.50000, -1.00000,  1.00000})
 
-    suite.UThree = NewMatrix(
+    suite.UThree = MustMatrix(
         []float64{2.00000,  4.00000,  7.00000},
         []float64{0.00000,  1.00000,  1.50000},
         []float64{0.00000,  0.00000, -2.00000})
 
-    suite.PThree = NewMatrix(
+    suite.PThree = MustMatrix(
         []float64{0,  1,  0},
         []float64{1,  0,  0},
         []float64{0,  0,  1})
 
-    suite.FourMatrix = NewMatrix(
+    suite.FourMatrix = MustMatrix(
         []float64{11,  9, 24,  2},
         []float64{ 1,  5,  2,  6},
         []float64{ 3, 17, 18,  1},
         []float64{ 2,  5,  7,  1})
 
-    suite.LFour = NewMatrix(
+    suite.LFour = MustMatrix(
         []float64{1.00000,  0.00000, 0.00000,  0.00000},
         []float64{0.27273,  1.00000, 0.00000,  0.00000},
         []float64{0.09091,  0.28750, 1.00000,  0.00000},
         []float64{0.18182,  0.23125, 0.00360,  1.00000})
 
-    suite.UFour = NewMatrix(
-        []float64{1.00000,  0.00000, 0.00000,  0.00000},
-        []float64{0.27273,  1.00000, 0.00000,  0.00000},
-        []float64{0.09091,  0.28750, 1.00000,  0.00000},
-        []float64{0.18182,  0.23125, 0.00360,  1.00000})
+    suite.UFour = MustMatrix(
+        []float64{11.00000,  9.00000, 24.00000,  2.00000},
+        []float64{ 0.00000, 14.54545, 11.45455,  0.45455},
+        []float64{ 0.00000,  0.00000, -3.47500,  5.68750},
+        []float64{ 0.00000,  0.00000,  0.00000,  0.51079})
     
-    suite.PFour = NewMatrix(
+    suite.PFour = MustMatrix(
         []float64{1, 0, 0, 0},
         []float64{0, 0, 1, 0},
         []float64{0, 1, 0, 0},
@@ -262,21 +312,82 @@ func (suite *LUPDecompTestSuite) SetupTest() {
 }
 
 func (suite *LUPDecompTestSuite) TestLUP() {
-    L1, U1, P1, err1 := suite.ThreeMatrix.LUP()
-    L2, U2, P2, err2 := suite.FourMatrix.LUP()
+    lu1, err1 := suite.ThreeMatrix.LUP()
+    lu2, err2 := suite.FourMatrix.LUP()
+    lu3, err3 := NonsquareMatrix.LUP()
 
-    suite.Equal(suite.LThree, L1, "They should be equal")
-    suite.Equal(suite.UThree, U1, "They should be equal")
-    suite.Equal(suite.PThree, P1, "They should be equal")
     suite.Equal(nil, err1, "There should be no error")
+    suite.Equal(suite.LThree, lu1.L(), "They should be equal")
+    suite.Equal(suite.UThree, lu1.U(), "They should be equal")
+    suite.Equal(suite.PThree, lu1.P(), "They should be equal")
 
-    suite.Equal(suite.LFour, L2, "They should be equal")
-    suite.Equal(suite.UFour, U2, "They should be equal")
-    suite.Equal(suite.PFour, P2, "They should be equal")
     suite.Equal(nil, err2, "There should be no error")
-   
+    matrixInDelta(&suite.Suite, suite.LFour, lu2.L(), 1e-5)
+    matrixInDelta(&suite.Suite, suite.UFour, lu2.U(), 1e-5)
+    suite.Equal(suite.PFour, lu2.P(), "They should be equal")
+
+    suite.Nil(lu3, "Non square Matrix has no LUP")
+    suite.NotEqual(nil, err3, "There should be an error")
+}
+
+// A zero pivot appearing mid-elimination must be 
+// pivoted away rather than divided by
+func (suite *LUPDecompTestSuite) TestZeroPivot() {
+    lu, err := MustMatrix(
+        []float64{1, 2, 3},
+        []float64{2, 4, 7},
+        []float64{1, 1, 1}).LUP()
+
+    suite.Equal(nil, err, "There should be no error")
+    suite.False(lu.IsSingular(), "Matrix is not singular")
+    suite.InDelta(1.0, lu.Det(), 1e-12)
+
+    x, err := lu.Solve([]float64{6, 13, 3})
+    suite.Equal(nil, err, "There should be no error")
+    suite.InDeltaSlice([]float64{1, 1, 1}, x, 1e-12)
 }
 
+func (suite *LUPDecompTestSuite) TestSolve() {
+    lu, _ := suite.FourMatrix.LUP()
+
+    x, err := lu.Solve([]float64{46, 14, 39, 15})
+    suite.Equal(nil, err, "There should be no error")
+    suite.InDeltaSlice([]float64{1, 1, 1, 1}, x, 1e-12)
+
+    _, err = lu.Solve([]float64{1, 2})
+    suite.NotEqual(nil, err, "There should be an error")
+
+    B := MustMatrix(
+        []float64{46, 11},
+        []float64{14, 1},
+        []float64{39, 3},
+        []float64{15, 2})
+    X, err := lu.SolveMatrix(B)
+    suite.Equal(nil, err, "There should be no error")
+    matrixInDelta(&suite.Suite, MustMatrix(
+        []float64{1, 1},
+        []float64{1, 0},
+        []float64{1, 0},
+        []float64{1, 0}), X, 1e-12)
+
+    logDet, sign := lu.LogDet()
+    suite.InDelta(lu.Det(), sign*math.Exp(logDet), 1e-9)
+}
+
+func (suite *LUPDecompTestSuite) TestSingular() {
+    lu, err := MustMatrix([]float64{2, -2}, []float64{-2, 2}).LUP()
+
+    suite.Equal(nil, err, "There should be no error")
+    suite.True(lu.IsSingular(), "Matrix is singular")
+    suite.Equal(0.0, lu.Det(), "They should be equal")
+
+    _, err = lu.Solve([]float64{1, 1})
+    suite.NotEqual(nil, err, "There should be an error")
+
+    logDet, sign := lu.LogDet()
+    suite.True(math.IsInf(logDet, -1), "log|det| should be -Inf")
+    suite.Equal(0.0, sign, "They should be equal")
+}
 
 //*******************************
 // Inverse of Matrices Test Suite
@@ -293,11 +404,11 @@ type InverseTestSuite struct {
 func (suite *InverseTestSuite) SetupTest() {
 
 
-    suite.ZeroDeterminantMatrix = NewMatrix([]float64{2, -2}, []float64{-2, 2})
+    suite.ZeroDeterminantMatrix = MustMatrix([]float64{2, -2}, []float64{-2, 2})
 
     suite.TenIdentity = Identity(10)
 
-    suite.RandMatrixInverse = NewMatrix(
+    suite.RandMatrixInverse = MustMatrix(
         []float64{-0.0460854, -0.0705092, 0.0326931, 0.045097, -0.164044, 0.0123061, 0.104152, -0.0198876, 0.0493236, 0.102875}, 
         []float64{0.141227, 0.325278, 0.0262483, -0.179546, 0.419574, -0.121419, -0.306189, -0.0357916, -0.162755, -0.362409}, 
         []float64{-0.0715951, -0.0268494, 0.0000621608, 0.029943, -0.0600711, 0.0617097, 0.0618292, 0.0345928, 0.0558978, 0.0424593}, 
@@ -326,7 +437,7 @@ func (suite *InverseTestSuite) TestInverse() {
     suite.Equal(suite.TenIdentity, inverse3, "Identity inverse is itself")
     suite.Equal(nil, err3, "There should be no error")
 
-    suite.Equal(suite.RandMatrixInverse, inverse4, "Identity inverse is itself")
+    matrixInDelta(&suite.Suite, suite.RandMatrixInverse, inverse4, 1e-5)
     suite.Equal(nil, err4, "There should be no error")
 }
 
@@ -355,7 +466,7 @@ func (suite *EigValDeterminantTestSuite) SetupTest() {
 
     suite.IdentityEigenVals = []complex128{1+0i, 1+0i, 1+0i}
 
-    suite.Uppertriangular1 = NewMatrix(
+    suite.Uppertriangular1 = MustMatrix(
         []float64{5, 10, 9, 3, 4}, 
         []float64{0, 4, -6, 7.234, -3}, 
         []float64{0, 0, 3, 13098.38, 239}, [
@@ -364,7 +475,7 @@ func (suite *EigValDeterminantTestSuite) SetupTest() {
 
     suite.Upper1EigenVals  = []complex128{5+0i, 4+0i, 3+0i, 2+0i, 1+0i}
 
-    suite.Uppertriangular2 = NewMatrix(
+    suite.Uppertriangular2 = MustMatrix(
         []float64{1, 10, 9, 3, 4}, 
         []float64{0, 3, -6, 7.234, -3}, 
         []float64{0, 0, 4, 13098.38, 239}, [
@@ -398,10 +509,10 @@ func (suite *EigValDeterminantTestSuite) TestDeterminant() {
     suite.Equal(det4, 120.0,"They should be equal")
     suite.Equal(err4, nil, "There should be no error")
 
-    suite.Equal(det5, 2.39872e+10, "They should be equal")
+    suite.InEpsilon(2.39872e+10, det5, 1e-5, "They should be equal")
     suite.Equal(err5, nil, "There should be no error")
 
-    suite.Equal(0.0, det6, "They should be equal")
+    suite.InDelta(1719.11628, det6, 1e-5, "They should be equal")
     suite.Equal(nil, err6, "There should be no error")
 
 }
@@ -414,7 +525,7 @@ func (suite *EigValDeterminantTestSuite) TestEigenvalues() {
     eval5, err5 := RandMatrix.Eigenvalues()
 
 
-    suite.Equal(eval1, nil, "There should be no eigenvalues")
+    suite.Nil(eval1, "There should be no eigenvalues")
     suite.NotEqual(err1, nil, "There should be an error")
 
     suite.Equal(eval2, suite.IdentityEigenVals, "They should be equal")
@@ -426,7 +537,17 @@ func (suite *EigValDeterminantTestSuite) TestEigenvalues() {
     suite.Equal(eval4, suite.Upper2EigenVals,"They should be equal")
     suite.Equal(err4, nil, "There should be no error")
 
-    suite.Equal(eval5, suite.RandEigenVals, "They should be equal")
+    // Expected values are rounded and in no particular order
+    suite.Equal(len(suite.RandEigenVals), len(eval5), "They should be equal")
+    for _, expected := range suite.RandEigenVals {
+        found := false
+        for _, actual := range eval5 {
+            if cmplx.Abs(expected - actual) < 1e-4 {
+                found = true
+            }
+        }
+        suite.True(found, "Eigenvalue %v should be found", expected)
+    }
     suite.Equal(err5, nil, "There should be no error")
 
 }
@@ -441,7 +562,7 @@ func TestAll(t *testing.T) {
     suite.Run(t, new(AdditionTestSuite))
     suite.Run(t, new(MultiplicationTestSuite))
     suite.Run(t, new(LUPDecompTestSuite))
-    //suite.Run(t, new(InverseTestSuite))
+    suite.Run(t, new(InverseTestSuite))
     suite.Run(t, new(EigValDeterminantTestSuite))
     
 }
@@ -0,0 +1,325 @@
+package golinal
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Header banner every Matrix Market file starts with
+const mmBanner = "%%MatrixMarket"
+
+// mmHeader is the parsed first line of a Matrix Market file
+type mmHeader struct {
+	coordinate bool
+	pattern    bool
+	symmetry   string
+}
+
+// brief: Reads a Matrix in Matrix Market format
+//
+// details: Supports the coordinate and array formats, the
+//          real, integer and pattern fields, and the general,
+//          symmetric and skew-symmetric qualifiers. Symmetric
+//          files only store one triangle, the other is filled
+//          in. Pattern entries are read as 1.
+//          See https://math.nist.gov/MatrixMarket/formats.html
+//
+// returns: the Matrix, or a *ParseError pointing at the
+//          offending line. The result is dense, so a size
+//          line over MaxDecodeEntries entries is an error
+//          however few nonzeros follow.
+func ReadMatrixMarket(r io.Reader) (*Matrix, error) {
+	scanner := bufio.NewScanner(r)
+	line := 0
+
+	// Next non-comment, non-blank line split into fields
+	next := func() ([]string, bool) {
+		for scanner.Scan() {
+			line++
+			text := strings.TrimSpace(scanner.Text())
+			if text == "" || strings.HasPrefix(text, "%") {
+				continue
+			}
+			return strings.Fields(text), true
+		}
+		return nil, false
+	}
+
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, &ParseError{Line: 1, Msg: "empty input, expected a " + mmBanner + " header"}
+	}
+	line++
+	header, err := parseMMHeader(scanner.Text())
+	if err != nil {
+		return nil, err
+	}
+
+	fields, ok := next()
+	if !ok {
+		return nil, mmEOF(scanner, line, "size line")
+	}
+	want := 2
+	if header.coordinate {
+		want = 3
+	}
+	sizes, err := parseInts(fields, want, line, "size line")
+	if err != nil {
+		return nil, err
+	}
+	rows, cols := sizes[0], sizes[1]
+	if header.symmetry != "general" && rows != cols {
+		return nil, &ParseError{Line: line, Msg: fmt.Sprintf("%s Matrix must be square, got %dx%d", header.symmetry, rows, cols)}
+	}
+	if err := checkDecodeSize(rows, cols); err != nil {
+		return nil, &ParseError{Line: line, Msg: err.Error()}
+	}
+	m := BlankMatrix(rows, cols)
+
+	// Sets (i, j) and its mirror image for the symmetric kinds
+	set := func(i, j int, v float64) error {
+		if header.symmetry == "skew-symmetric" && i == j && v != 0 {
+			return &ParseError{Line: line, Msg: "skew-symmetric Matrix has a nonzero diagonal entry"}
+		}
+		m.data[i*m.stride+j] = v
+		switch header.symmetry {
+		case "symmetric":
+			m.data[j*m.stride+i] = v
+		case "skew-symmetric":
+			m.data[j*m.stride+i] = -v
+		}
+		return nil
+	}
+
+	if header.coordinate {
+		entries := sizes[2]
+		want := 3
+		if header.pattern {
+			want = 2
+		}
+		for k := 0; k < entries; k++ {
+			fields, ok := next()
+			if !ok {
+				return nil, mmEOF(scanner, line, fmt.Sprintf("entry %d of %d", k+1, entries))
+			}
+			if len(fields) != want {
+				return nil, &ParseError{Line: line, Msg: fmt.Sprintf("expected %d fields, got %d", want, len(fields))}
+			}
+			index, err := parseInts(fields[:2], 2, line, "entry")
+			if err != nil {
+				return nil, err
+			}
+			i, j := index[0]-1, index[1]-1
+			if i < 0 || i >= rows || j < 0 || j >= cols {
+				return nil, &ParseError{Line: line, Msg: fmt.Sprintf("index (%d, %d) out of range for %dx%d Matrix", i+1, j+1, rows, cols)}
+			}
+			v := 1.0
+			if !header.pattern {
+				if v, err = parseFloat(fields[2], line); err != nil {
+					return nil, err
+				}
+			}
+			if err := set(i, j, v); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		// Column-major, only the lower triangle for the
+		// symmetric kinds, strictly lower for skew-symmetric
+		for j := 0; j < cols; j++ {
+			start := 0
+			switch header.symmetry {
+			case "symmetric":
+				start = j
+			case "skew-symmetric":
+				start = j + 1
+			}
+			for i := start; i < rows; i++ {
+				fields, ok := next()
+				if !ok {
+					return nil, mmEOF(scanner, line, fmt.Sprintf("entry (%d, %d)", i+1, j+1))
+				}
+				if len(fields) != 1 {
+					return nil, &ParseError{Line: line, Msg: fmt.Sprintf("expected 1 field, got %d", len(fields))}
+				}
+				v, err := parseFloat(fields[0], line)
+				if err != nil {
+					return nil, err
+				}
+				if err := set(i, j, v); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	if _, extra := next(); extra {
+		return nil, &ParseError{Line: line, Msg: "unexpected data after the last entry"}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// brief: Writes a Matrix in Matrix Market format
+//
+// details: The coordinate format is used when at most half
+//          the entries are nonzero, the array format otherwise.
+//          A symmetric Matrix is written as such, lower triangle
+//          only. Values are written with the fewest digits that
+//          read back exactly.
+//
+// returns: any error from w
+func (m *Matrix) WriteMatrixMarket(w io.Writer) error {
+	symmetric := m.IsSymmetric(0)
+
+	// The entries that get written, column-major
+	type entry struct {
+		i, j int
+		v    float64
+	}
+	var entries []entry
+	nnz := 0
+	for j := 0; j < m.numCols; j++ {
+		start := 0
+		if symmetric {
+			start = j
+		}
+		for i := start; i < m.numRows; i++ {
+			v := m.At(i, j)
+			entries = append(entries, entry{i, j, v})
+			if v != 0 {
+				nnz++
+			}
+		}
+	}
+	coordinate := 2*nnz <= len(entries)
+
+	format, symmetry := "array", "general"
+	if coordinate {
+		format = "coordinate"
+	}
+	if symmetric {
+		symmetry = "symmetric"
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%s matrix %s real %s\n", mmBanner, format, symmetry)
+	if coordinate {
+		fmt.Fprintf(bw, "%d %d %d\n", m.numRows, m.numCols, nnz)
+	} else {
+		fmt.Fprintf(bw, "%d %d\n", m.numRows, m.numCols)
+	}
+	for _, e := range entries {
+		value := strconv.FormatFloat(e.v, 'g', -1, 64)
+		if !coordinate {
+			fmt.Fprintln(bw, value)
+		} else if e.v != 0 {
+			fmt.Fprintf(bw, "%d %d %s\n", e.i+1, e.j+1, value)
+		}
+	}
+
+	return bw.Flush()
+}
+
+// brief: Parses a %%MatrixMarket header line
+//
+// returns: the header, or a *ParseError naming the
+//          unsupported or malformed part
+func parseMMHeader(text string) (*mmHeader, error) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 || fields[0] != mmBanner {
+		return nil, &ParseError{Line: 1, Msg: "missing " + mmBanner + " header"}
+	}
+	if len(fields) != 5 {
+		return nil, &ParseError{Line: 1, Msg: fmt.Sprintf("header should have 5 fields, got %d", len(fields))}
+	}
+	for i := 1; i < 5; i++ {
+		fields[i] = strings.ToLower(fields[i])
+	}
+	object, format, field, symmetry := fields[1], fields[2], fields[3], fields[4]
+
+	if object != "matrix" {
+		return nil, &ParseError{Line: 1, Msg: fmt.Sprintf("unsupported object %q, expected matrix", object)}
+	}
+
+	h := &mmHeader{symmetry: symmetry}
+	switch format {
+	case "coordinate":
+		h.coordinate = true
+	case "array":
+	default:
+		return nil, &ParseError{Line: 1, Msg: fmt.Sprintf("unsupported format %q, expected coordinate or array", format)}
+	}
+
+	switch field {
+	case "real", "integer":
+	case "pattern":
+		if !h.coordinate {
+			return nil, &ParseError{Line: 1, Msg: "pattern field requires the coordinate format"}
+		}
+		h.pattern = true
+	default:
+		return nil, &ParseError{Line: 1, Msg: fmt.Sprintf("unsupported field %q, expected real, integer or pattern", field)}
+	}
+
+	switch symmetry {
+	case "general", "symmetric":
+	case "skew-symmetric":
+		if h.pattern {
+			return nil, &ParseError{Line: 1, Msg: "pattern field can't be skew-symmetric"}
+		}
+	default:
+		return nil, &ParseError{Line: 1, Msg: fmt.Sprintf("unsupported symmetry %q, expected general, symmetric or skew-symmetric", symmetry)}
+	}
+
+	return h, nil
+}
+
+// brief: Parses exactly n non-negative integers
+//
+// returns: the integers, or a *ParseError mentioning what
+func parseInts(fields []string, n, line int, what string) ([]int, error) {
+	if len(fields) != n {
+		return nil, &ParseError{Line: line, Msg: fmt.Sprintf("%s should have %d fields, got %d", what, n, len(fields))}
+	}
+
+	ints := make([]int, n)
+	for k, f := range fields {
+		v, err := strconv.Atoi(f)
+		if err != nil || v < 0 {
+			return nil, &ParseError{Line: line, Msg: fmt.Sprintf("%s has invalid integer %q", what, f)}
+		}
+		ints[k] = v
+	}
+
+	return ints, nil
+}
+
+// brief: Parses a float, reporting failures as a *ParseError
+func parseFloat(field string, line int) (float64, error) {
+	v, err := strconv.ParseFloat(field, 64)
+	if err != nil {
+		return 0, &ParseError{Line: line, Msg: fmt.Sprintf("invalid number %q", field)}
+	}
+
+	return v, nil
+}
+
+// brief: Reports running out of input while expecting what
+//
+// returns: the scanner's error if it failed, otherwise a *ParseError
+func mmEOF(scanner *bufio.Scanner, line int, what string) error {
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	return &ParseError{Line: line, Msg: "unexpected end of input, expected " + what}
+}
@@ -0,0 +1,150 @@
+package golinal
+
+import (
+    "github.com/stretchr/testify/suite";
+    "bytes";
+    "errors";
+    "strings";
+    "testing"
+)
+
+//*******************************
+// Matrix Market Test Suite
+//*******************************
+
+type MatrixMarketTestSuite struct {
+    suite.Suite
+}
+
+func (suite *MatrixMarketTestSuite) TestReadCoordinate() {
+    m, err := ReadMatrixMarket(strings.NewReader(`%%MatrixMarket matrix coordinate real general
+% a comment
+3 4 3
+
+1 1 1.5
+3 2 -2e3
+2 4 7
+`))
+
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal(MustMatrix(
+        []float64{1.5, 0, 0, 0},
+        []float64{0, 0, 0, 7},
+        []float64{0, -2000, 0, 0}), m, "They should be equal")
+}
+
+func (suite *MatrixMarketTestSuite) TestReadQualifiers() {
+    m, err := ReadMatrixMarket(strings.NewReader(`%%MatrixMarket matrix coordinate integer symmetric
+3 3 3
+1 1 2
+3 1 -1
+3 2 4
+`))
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal(MustMatrix(
+        []float64{ 2, 0, -1},
+        []float64{ 0, 0,  4},
+        []float64{-1, 4,  0}), m, "They should be equal")
+
+    m, err = ReadMatrixMarket(strings.NewReader(`%%MatrixMarket matrix coordinate pattern general
+2 2 2
+1 2
+2 1
+`))
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal(MustMatrix([]float64{0, 1}, []float64{1, 0}), m, "They should be equal")
+
+    // Array format is column-major, lower triangle only when skew
+    m, err = ReadMatrixMarket(strings.NewReader(`%%MatrixMarket matrix array real skew-symmetric
+3 3
+1
+2
+3
+`))
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal(MustMatrix(
+        []float64{0, -1, -2},
+        []float64{1,  0, -3},
+        []float64{2,  3,  0}), m, "They should be equal")
+
+    m, err = ReadMatrixMarket(strings.NewReader(`%%MatrixMarket MATRIX Array Real General
+2 3
+1
+4
+2
+5
+3
+6
+`))
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal(MustMatrix([]float64{1, 2, 3}, []float64{4, 5, 6}), m, "They should be equal")
+}
+
+// Writing then reading gives back the same Matrix exactly,
+// whichever format the writer picks
+func (suite *MatrixMarketTestSuite) TestRoundTrip() {
+    symmetric := MustMatrix(
+        []float64{1, 0.1, 0},
+        []float64{0.1, 2, 0},
+        []float64{0, 0, 1.0/3})
+
+    for _, m := range []*Matrix{RandMatrix, RandFourMatrix, Identity(5), symmetric, NonsquareMatrix, BlankMatrix(2, 3)} {
+        var buf bytes.Buffer
+        suite.Equal(nil, m.WriteMatrixMarket(&buf), "There should be no error")
+
+        read, err := ReadMatrixMarket(&buf)
+        suite.Equal(nil, err, "There should be no error")
+        suite.Equal(m, read, "They should be equal")
+    }
+
+    var buf bytes.Buffer
+    Identity(3).WriteMatrixMarket(&buf)
+    suite.Equal("%%MatrixMarket matrix coordinate real symmetric\n3 3 3\n1 1 1\n2 2 1\n3 3 1\n", buf.String(), "They should be equal")
+
+    buf.Reset()
+    MustMatrix([]float64{1, 2}, []float64{3, 4}).WriteMatrixMarket(&buf)
+    suite.Equal("%%MatrixMarket matrix array real general\n2 2\n1\n3\n2\n4\n", buf.String(), "They should be equal")
+}
+
+func (suite *MatrixMarketTestSuite) TestMalformed() {
+    cases := []struct {
+        input string
+        line  int
+        msg   string
+    }{
+        {"", 1, "empty input"},
+        {"3 3 1\n", 1, "missing %%MatrixMarket header"},
+        {"%%MatrixMarket matrix coordinate real\n", 1, "header should have 5 fields"},
+        {"%%MatrixMarket vector coordinate real general\n", 1, "unsupported object"},
+        {"%%MatrixMarket matrix dense real general\n", 1, "unsupported format"},
+        {"%%MatrixMarket matrix coordinate complex general\n", 1, "unsupported field"},
+        {"%%MatrixMarket matrix array pattern general\n", 1, "pattern field requires"},
+        {"%%MatrixMarket matrix coordinate real hermitian\n", 1, "unsupported symmetry"},
+        {"%%MatrixMarket matrix coordinate real general\n%\n", 2, "expected size line"},
+        {"%%MatrixMarket matrix coordinate real general\n2 2\n", 2, "size line should have 3 fields"},
+        {"%%MatrixMarket matrix array real symmetric\n2 3\n", 2, "must be square"},
+        {"%%MatrixMarket matrix coordinate real general\n2 2 2\n1 1 1\n", 3, "expected entry 2 of 2"},
+        {"%%MatrixMarket matrix coordinate real general\n2 2 1\n3 1 1\n", 3, "out of range"},
+        {"%%MatrixMarket matrix coordinate real general\n2 2 1\n1 1 x\n", 3, "invalid number"},
+        {"%%MatrixMarket matrix coordinate real general\n2 2 1\n1 1\n", 3, "expected 3 fields"},
+        {"%%MatrixMarket matrix coordinate real skew-symmetric\n2 2 1\n1 1 1\n", 3, "nonzero diagonal"},
+        {"%%MatrixMarket matrix array real general\n1 1\n1\n2\n", 4, "unexpected data"},
+        {"%%MatrixMarket matrix coordinate real general\n1000000 1000000 3\n", 2, "larger than MaxDecodeEntries"},
+        {"%%MatrixMarket matrix coordinate real general\n4611686018427387904 4 1\n", 2, "larger than MaxDecodeEntries"},
+    }
+
+    for _, c := range cases {
+        _, err := ReadMatrixMarket(strings.NewReader(c.input))
+
+        var parse *ParseError
+        suite.True(errors.As(err, &parse), "Error should be a *ParseError for %q", c.input)
+        if parse != nil {
+            suite.Equal(c.line, parse.Line, "They should be equal for %q", c.input)
+            suite.Contains(parse.Msg, c.msg)
+        }
+    }
+}
+
+func TestMatrixMarket(t *testing.T) {
+    suite.Run(t, new(MatrixMarketTestSuite))
+}
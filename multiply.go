@@ -0,0 +1,89 @@
+package golinal
+
+import (
+	"runtime"
+	"sync"
+)
+
+const (
+	// Side length of the square tiles the product is split
+	// into. 64x64 float64 tiles of the three operands fit
+	// comfortably in L2
+	blockSize = 64
+
+	// Below this many multiply-adds (rows*inner*cols) the cost
+	// of starting goroutines outweighs the gain, and the
+	// product is computed on the calling goroutine
+	parallelThreshold = 64 * 64 * 64
+)
+
+// brief: Computes c = a*b with a cache-blocked kernel
+//
+// details: The output is split into blockSize x blockSize
+//          tiles, each owned by a single goroutine, so
+//          no locking is needed on c. Large products are
+//          spread over runtime.GOMAXPROCS(0) workers.
+//
+// note: c must be a zeroed a.numRows x b.numCols Dense
+func multiply[T Scalar](c, a, b *Dense[T]) {
+	workers := runtime.GOMAXPROCS(0)
+	if workers == 1 || a.numRows*a.numCols*b.numCols < parallelThreshold {
+		for i0 := 0; i0 < c.numRows; i0 += blockSize {
+			for j0 := 0; j0 < c.numCols; j0 += blockSize {
+				multiplyBlock(c, a, b, i0, j0)
+			}
+		}
+		return
+	}
+
+	rowBlocks := (c.numRows + blockSize - 1) / blockSize
+	colBlocks := (c.numCols + blockSize - 1) / blockSize
+	if tiles := rowBlocks * colBlocks; tiles < workers {
+		workers = tiles
+	}
+
+	tiles := make(chan int, rowBlocks*colBlocks)
+	for t := 0; t < rowBlocks*colBlocks; t++ {
+		tiles <- t
+	}
+	close(tiles)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for t := range tiles {
+				multiplyBlock(c, a, b, (t/colBlocks)*blockSize, (t%colBlocks)*blockSize)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// brief: Accumulates the (i0, j0) output tile of c = a*b
+//
+// details: i-k-j ordering, so the innermost loop streams
+//          along rows of b and c. For each entry the
+//          products are still summed in increasing k, as
+//          in the textbook triple loop.
+func multiplyBlock[T Scalar](c, a, b *Dense[T], i0, j0 int) {
+	i1 := min(i0+blockSize, c.numRows)
+	j1 := min(j0+blockSize, c.numCols)
+
+	for k0 := 0; k0 < a.numCols; k0 += blockSize {
+		k1 := min(k0+blockSize, a.numCols)
+		for i := i0; i < i1; i++ {
+			aRow := a.rowView(i)
+			cRow := c.rowView(i)[j0:j1]
+			for k := k0; k < k1; k++ {
+				// Not axpy(), which skips a zero a_ik and
+				// would give 0 rather than NaN for 0 * Inf
+				aik := aRow[k]
+				for j, v := range b.rowView(k)[j0:j1] {
+					cRow[j] += aik * v
+				}
+			}
+		}
+	}
+}
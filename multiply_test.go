@@ -0,0 +1,114 @@
+package golinal
+
+import (
+    "github.com/stretchr/testify/suite";
+    "fmt";
+    "math";
+    "math/rand";
+    "testing"
+)
+
+// brief: Creates a rows x cols Matrix with entries
+// uniform in [-10, 10), reproducible from seed
+func randomMatrix(rows, cols int, seed int64) *Matrix {
+    r := rand.New(rand.NewSource(seed))
+    m := BlankMatrix(rows, cols)
+    for i := range m.data {
+        m.data[i] = 20*r.Float64() - 10
+    }
+
+    return m
+}
+
+// brief: The textbook i-j-k triple loop, kept as
+// the reference the blocked kernel is checked and
+// benchmarked against
+func multiplyNaive(a, b *Matrix) *Matrix {
+    c := BlankMatrix(a.numRows, b.numCols)
+    for i := 0; i < a.numRows; i++ {
+        for j := 0; j < b.numCols; j++ {
+            sum := 0.0
+            for k := 0; k < a.numCols; k++ {
+                sum += a.At(i, k) * b.At(k, j)
+            }
+            c.data[i*c.stride+j] = sum
+        }
+    }
+
+    return c
+}
+
+
+//*******************************
+// Blocked Multiplication Test Suite
+//*******************************
+
+type BlockedMultiplyTestSuite struct {
+    suite.Suite
+}
+
+// Shapes that aren't multiples of the tile size,
+// both below and above the parallel threshold
+func (suite *BlockedMultiplyTestSuite) TestAgainstNaive() {
+    shapes := [][3]int{{1, 1, 1}, {3, 70, 5}, {65, 1, 130}, {130, 97, 200}, {257, 64, 129}}
+
+    for s, shape := range shapes {
+        a := randomMatrix(shape[0], shape[1], int64(2*s))
+        b := randomMatrix(shape[1], shape[2], int64(2*s+1))
+
+        c, err := a.Multiply(b)
+        suite.Equal(nil, err, "There should be no error")
+        matrixInDelta(&suite.Suite, multiplyNaive(a, b), c, 1e-9)
+    }
+}
+
+// IEEE rules hold as in the naive loop, 0 * Inf is NaN
+func (suite *BlockedMultiplyTestSuite) TestNaNInf() {
+    a := MustMatrix([]float64{0, 1})
+    b := MustMatrix([]float64{math.Inf(1)}, []float64{2})
+    c, err := a.Multiply(b)
+    suite.Equal(nil, err, "There should be no error")
+    suite.True(math.IsNaN(c.At(0, 0)), "0 * Inf should give NaN")
+    suite.True(math.IsNaN(multiplyNaive(a, b).At(0, 0)), "The naive loop should agree")
+
+    b.Set(0, 0, math.NaN())
+    c, _ = MustMatrix([]float64{0, 0}).Multiply(b)
+    suite.True(math.IsNaN(c.At(0, 0)), "NaN should propagate past a zero")
+
+    b.Set(0, 0, math.Inf(-1))
+    c, _ = MustMatrix([]float64{3, 0}).Multiply(b)
+    suite.True(math.IsInf(c.At(0, 0), -1), "They should be equal")
+}
+
+func TestBlockedMultiply(t *testing.T) {
+    suite.Run(t, new(BlockedMultiplyTestSuite))
+}
+
+
+//***********
+// Benchmarks
+//***********
+
+var benchSizes = []int{64, 256, 1024}
+
+func BenchmarkMultiplyNaive(b *testing.B) {
+    for _, n := range benchSizes {
+        x, y := randomMatrix(n, n, 1), randomMatrix(n, n, 2)
+        b.Run(fmt.Sprintf("%d", n), func(b *testing.B) {
+            for i := 0; i < b.N; i++ {
+                multiplyNaive(x, y)
+            }
+        })
+    }
+}
+
+func BenchmarkMultiply(b *testing.B) {
+    for _, n := range benchSizes {
+        x, y := randomMatrix(n, n, 1), randomMatrix(n, n, 2)
+        b.Run(fmt.Sprintf("%d", n), func(b *testing.B) {
+            for i := 0; i < b.N; i++ {
+                x.Multiply(y)
+            }
+        })
+    }
+}
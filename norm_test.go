@@ -0,0 +1,114 @@
+package golinal
+
+import (
+    "github.com/stretchr/testify/suite";
+    "errors";
+    "math";
+    "testing"
+)
+
+//*************************************
+// Norms and Condition Test Suite
+//*************************************
+
+type NormTestSuite struct {
+    suite.Suite
+
+    A *Matrix
+}
+
+func (suite *NormTestSuite) SetupTest() {
+    suite.A = MustMatrix(
+        []float64{1, -2, 3},
+        []float64{-4, 5, -6},
+    )
+}
+
+// n x n Hilbert matrix, 1/(i+j+1)
+func hilbert(n int) *Matrix {
+    h := BlankMatrix(n, n)
+    for i := 0; i < n; i++ {
+        for j := 0; j < n; j++ {
+            h.Set(i, j, 1/float64(i+j+1))
+        }
+    }
+
+    return h
+}
+
+func (suite *NormTestSuite) TestNorm() {
+    suite.Equal(9.0, suite.A.Norm(NormOne), "They should be equal")
+    suite.Equal(15.0, suite.A.Norm(NormInf), "They should be equal")
+    suite.InDelta(math.Sqrt(91), suite.A.Norm(NormFrobenius), 1e-14, "They should be equal")
+    suite.Equal(6.0, suite.A.Norm(NormMaxAbs), "They should be equal")
+    suite.True(math.IsNaN(suite.A.Norm(NormKind(-1))), "An unknown kind should give NaN")
+    suite.Equal(0.0, BlankMatrix(0, 0).Norm(NormOne), "They should be equal")
+
+    // Views only see their own entries
+    v, _ := suite.A.Slice(0, 2, 1, 2)
+    suite.Equal(7.0, v.Norm(NormOne), "They should be equal")
+
+    huge := MustMatrix([]float64{1e300, 1e300})
+    suite.InDelta(math.Sqrt2, huge.Norm(NormFrobenius)/1e300, 1e-14, "It shouldn't overflow")
+}
+
+func (suite *NormTestSuite) TestCond1() {
+    for _, m := range []*Matrix{RandMatrix, RandFourMatrix, hilbert(6), ThreeIdentity} {
+        inverse, err := m.Inverse()
+        suite.Equal(err, nil, "There should be no error")
+        exact := m.Norm(NormOne) * inverse.Norm(NormOne)
+
+        est, err := m.Cond1()
+        suite.Equal(err, nil, "There should be no error")
+        suite.LessOrEqual(est, exact*(1+1e-8), "The estimate should be a lower bound")
+        suite.GreaterOrEqual(est, exact/3, "The estimate should be within a factor of 3")
+    }
+
+    _, err := NonsquareMatrix.Cond1()
+    suite.Equal(ErrNotSquare, err, "They should be equal")
+
+    lu, _ := BlankMatrix(2, 2).LUP()
+    suite.True(math.IsInf(lu.Cond1(), 1), "A singular Matrix should give +Inf")
+}
+
+func (suite *NormTestSuite) TestSolveTransposed() {
+    b := []float64{1, -2, 3, 0.5}
+    lu, _ := RandFourMatrix.LUP()
+    want, _ := RandFourMatrix.T().Gauss(b)
+    suite.InDeltaSlice(want, lu.solveTransposed(b), 1e-10, "They should be equal")
+}
+
+func (suite *NormTestSuite) TestIllConditioned() {
+    h := hilbert(14)
+    b := make([]float64, 14)
+    for i := range b {
+        b[i] = 1
+    }
+
+    x, err := h.Gauss(b)
+    suite.Equal(err, nil, "There should be no error")
+    x2, cond, err := h.GaussCond(b)
+    suite.Equal(err, nil, "There should be no error")
+    suite.Equal(x, x2, "They should be equal")
+    suite.Greater(cond, 1/eps, "The condition number should be reported")
+
+    inverse, err := h.Inverse()
+    suite.Equal(err, nil, "There should be no error")
+    inverse2, cond2, err := h.InverseCond()
+    suite.Equal(err, nil, "There should be no error")
+    suite.Equal(inverse, inverse2, "They should be equal")
+    suite.Equal(cond, cond2, "They should be equal")
+
+    _, cond, err = RandMatrix.GaussCond(make([]float64, 10))
+    suite.Equal(err, nil, "There should be no error")
+    suite.Less(cond, 1/eps, "RandMatrix should be well conditioned")
+
+    _, _, err = NonsquareMatrix.GaussCond([]float64{1, 2})
+    suite.Equal(err, ErrNotSquare, "Error should match ErrNotSquare")
+    _, _, err = BlankMatrix(2, 2).InverseCond()
+    suite.True(errors.Is(err, &ErrSingular{}), "Error should match ErrSingular")
+}
+
+func TestNorm(t *testing.T) {
+    suite.Run(t, new(NormTestSuite))
+}
@@ -0,0 +1,192 @@
+package golinal
+
+import (
+	"errors"
+)
+
+// Preconditioner approximates A by some M that is cheap to
+// solve with. The Krylov solvers call Solve once or twice per
+// iteration, so the closer M is to A the fewer iterations
+// they take.
+type Preconditioner interface {
+	// Solve returns z with Mz = r, without modifying r
+	Solve(r *Vector) (*Vector, error)
+}
+
+// Jacobi Struct Definition
+//
+// details: The diagonal preconditioner M = diag(A). Cheap
+//          and symmetric, so it's safe to use with CG.
+type Jacobi struct {
+	inv []float64
+}
+
+// ILU0 Struct Definition
+//
+// details: Incomplete LU factorization with zero fill-in,
+//          M = LU where L and U keep exactly the sparsity of A.
+//          L is unit lower triangular and stored without its
+//          diagonal, packed with U in one CSR Matrix.
+type ILU0 struct {
+	lu   *CSR
+	diag []int
+}
+
+// brief: Builds a Jacobi preconditioner
+//
+// inputs: a, a square *Matrix, *CSR or *CSC
+//
+// returns: the preconditioner, ErrNotSquare, an *ErrSingular
+//          naming the first zero diagonal entry, or an error
+//          if a isn't a supported type
+func NewJacobi(a Operator) (*Jacobi, error) {
+	s, err := toCSR(a)
+	if err != nil {
+		return nil, err
+	}
+
+	inv := make([]float64, s.rows)
+	for i := range inv {
+		d := s.At(i, i)
+		if d == 0 {
+			return nil, &ErrSingular{Index: i}
+		}
+		inv[i] = 1 / d
+	}
+
+	return &Jacobi{inv: inv}, nil
+}
+
+// brief: Solves diag(A) z = r
+//
+// returns: z, or an *ErrShape if r has the wrong length
+func (p *Jacobi) Solve(r *Vector) (*Vector, error) {
+	if r.n != len(p.inv) {
+		return nil, errShape("solve", len(p.inv), len(p.inv), r.n, 1)
+	}
+
+	z := BlankVector(r.n)
+	for i, x := range r.elems {
+		z.elems[i] = p.inv[i] * x
+	}
+
+	return z, nil
+}
+
+// brief: Builds an ILU(0) preconditioner
+//
+// details: Gaussian elimination restricted to the nonzero
+//          pattern of A, row by row (IKJ), so any fill-in
+//          is dropped. No pivoting. O(nnz * nnz per row)
+//
+// inputs: a, a square *Matrix, *CSR or *CSC. For a *Matrix
+//         the pattern is that of its nonzero entries
+//
+// returns: the preconditioner, ErrNotSquare, an *ErrSingular
+//          naming the first zero pivot, or an error if a isn't
+//          a supported type
+func NewILU0(a Operator) (*ILU0, error) {
+	s, err := toCSR(a)
+	if err != nil {
+		return nil, err
+	}
+
+	// Work on a copy of A's entries, same pattern
+	lu := &CSR{rows: s.rows, cols: s.cols, indptr: s.indptr, indices: s.indices}
+	lu.data = append([]float64(nil), s.data...)
+
+	n := lu.rows
+	diag := make([]int, n)
+	position := make([]int, n)
+	for i := range position {
+		position[i] = -1
+	}
+
+	for i := 0; i < n; i++ {
+		start, end := lu.indptr[i], lu.indptr[i+1]
+		for k := start; k < end; k++ {
+			position[lu.indices[k]] = k
+		}
+
+		// For each l_ik in the row, in increasing k, eliminate
+		// with row k of U wherever the pattern of row i allows
+		diag[i] = -1
+		for kk := start; kk < end; kk++ {
+			k := lu.indices[kk]
+			if k >= i {
+				if k == i {
+					diag[i] = kk
+				}
+				break
+			}
+			lu.data[kk] /= lu.data[diag[k]]
+			lik := lu.data[kk]
+			for jj := diag[k] + 1; jj < lu.indptr[k+1]; jj++ {
+				if p := position[lu.indices[jj]]; p >= 0 {
+					lu.data[p] -= lik * lu.data[jj]
+				}
+			}
+		}
+		if diag[i] < 0 || lu.data[diag[i]] == 0 {
+			return nil, &ErrSingular{Index: i}
+		}
+
+		for k := start; k < end; k++ {
+			position[lu.indices[k]] = -1
+		}
+	}
+
+	return &ILU0{lu: lu, diag: diag}, nil
+}
+
+// brief: Solves LUz = r
+//
+// details: forward substitution with L followed by
+//          backward substitution with U, O(nnz)
+//
+// returns: z, or an *ErrShape if r has the wrong length
+func (p *ILU0) Solve(r *Vector) (*Vector, error) {
+	lu := p.lu
+	n := lu.rows
+	if r.n != n {
+		return nil, errShape("solve", n, n, r.n, 1)
+	}
+
+	z := NewVector(r.elems...)
+	x := z.elems
+	for i := 0; i < n; i++ {
+		for k := lu.indptr[i]; k < p.diag[i]; k++ {
+			x[i] -= lu.data[k] * x[lu.indices[k]]
+		}
+	}
+	for i := n - 1; i >= 0; i-- {
+		for k := p.diag[i] + 1; k < lu.indptr[i+1]; k++ {
+			x[i] -= lu.data[k] * x[lu.indices[k]]
+		}
+		x[i] /= lu.data[p.diag[i]]
+	}
+
+	return z, nil
+}
+
+// brief: Gets a square Operator as a CSR Matrix
+//
+// returns: the CSR, ErrNotSquare, or an error if
+//          a isn't a *Matrix, *CSR or *CSC
+func toCSR(a Operator) (*CSR, error) {
+	rows, cols := a.Dims()
+	if rows != cols {
+		return nil, ErrNotSquare
+	}
+
+	switch a := a.(type) {
+	case *Matrix:
+		return FromDense(a, 0), nil
+	case *CSR:
+		return a, nil
+	case *CSC:
+		return a.ToCSR(), nil
+	}
+
+	return nil, errors.New("Preconditioner needs a *Matrix, *CSR or *CSC")
+}
@@ -0,0 +1,104 @@
+package golinal
+
+import (
+    "github.com/stretchr/testify/suite";
+    "errors";
+    "testing"
+)
+
+//*******************************
+// Preconditioner Test Suite
+//*******************************
+
+type PreconditionerTestSuite struct {
+    suite.Suite
+
+    Tridiagonal *Matrix
+}
+
+func (suite *PreconditionerTestSuite) SetupTest() {
+    suite.Tridiagonal = MustMatrix(
+        []float64{ 4, -1,  0,  0},
+        []float64{-2,  4, -1,  0},
+        []float64{ 0, -2,  4, -1},
+        []float64{ 0,  0, -2,  4})
+}
+
+func (suite *PreconditionerTestSuite) TestJacobi() {
+    jacobi, err := NewJacobi(suite.Tridiagonal)
+    suite.Equal(nil, err, "There should be no error")
+
+    z, err := jacobi.Solve(NewVector(4, 8, -2, 1))
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal(NewVector(1, 2, -0.5, 0.25), z, "They should be equal")
+
+    _, err = jacobi.Solve(NewVector(1))
+    suite.True(errors.Is(err, &ErrShape{}), "Error should match ErrShape")
+}
+
+// A tridiagonal Matrix has no fill-in, so ILU(0) is its exact LU
+func (suite *PreconditionerTestSuite) TestILU0Exact() {
+    b := []float64{1, 2, 3, 4}
+    x, _ := suite.Tridiagonal.Gauss(b)
+
+    for _, a := range []Operator{suite.Tridiagonal, FromDense(suite.Tridiagonal, 0), FromDense(suite.Tridiagonal, 0).ToCSC()} {
+        ilu, err := NewILU0(a)
+        suite.Equal(nil, err, "There should be no error")
+        z, err := ilu.Solve(NewVector(b...))
+        suite.Equal(nil, err, "There should be no error")
+        suite.InDeltaSlice(x, z.elems, 1e-12)
+    }
+}
+
+// With fill-in dropped, L and U match A on its pattern only
+func (suite *PreconditionerTestSuite) TestILU0Pattern() {
+    A := MustMatrix(
+        []float64{4, 1, 1},
+        []float64{1, 4, 0},
+        []float64{1, 0, 4})
+    ilu, _ := NewILU0(A)
+
+    L, U := BlankMatrix(3, 3), BlankMatrix(3, 3)
+    lu := ilu.lu.ToDense()
+    for i := 0; i < 3; i++ {
+        for j := 0; j < 3; j++ {
+            switch {
+            case i > j:
+                L.Set(i, j, lu.At(i, j))
+            case i == j:
+                L.Set(i, j, 1)
+                U.Set(i, j, lu.At(i, j))
+            default:
+                U.Set(i, j, lu.At(i, j))
+            }
+        }
+    }
+    LU, _ := L.Multiply(U)
+    for i := 0; i < 3; i++ {
+        for j := 0; j < 3; j++ {
+            if A.At(i, j) != 0 {
+                suite.InDelta(A.At(i, j), LU.At(i, j), 1e-12)
+            }
+        }
+    }
+    suite.Equal(0.0, lu.At(1, 2), "Fill-in should be dropped")
+    suite.NotEqual(0.0, LU.At(1, 2), "Dropped fill-in shows up in LU")
+}
+
+func (suite *PreconditionerTestSuite) TestErrors() {
+    var singular *ErrSingular
+    _, err := NewJacobi(MustMatrix([]float64{1, 2}, []float64{3, 0}))
+    suite.True(errors.As(err, &singular), "Error should be an *ErrSingular")
+    suite.Equal(1, singular.Index, "They should be equal")
+
+    _, err = NewILU0(MustMatrix([]float64{0, 1}, []float64{1, 0}))
+    suite.True(errors.As(err, &singular), "Error should be an *ErrSingular")
+    suite.Equal(0, singular.Index, "They should be equal")
+
+    _, err = NewILU0(NonsquareMatrix)
+    suite.True(errors.Is(err, ErrNotSquare), "Error should be ErrNotSquare")
+}
+
+func TestPreconditioner(t *testing.T) {
+    suite.Run(t, new(PreconditionerTestSuite))
+}
@@ -0,0 +1,178 @@
+package golinal
+
+import (
+	"math"
+)
+
+// brief: Reports whether m equals its transpose
+//
+// inputs: tol, the largest |a_ij - a_ji| allowed, 0 for
+//         exact symmetry
+//
+// returns: true if m is square and symmetric within tol
+func (m *Matrix) IsSymmetric(tol float64) bool {
+	if !m.IsSquare() {
+		return false
+	}
+	for i := 0; i < m.numRows; i++ {
+		for j := 0; j < i; j++ {
+			if !(math.Abs(m.At(i, j)-m.At(j, i)) <= tol) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// brief: Reports whether every entry off the diagonal is zero
+//
+// note: m needn't be square
+func (m *Matrix) IsDiagonal() bool {
+	return m.IsBanded(0, 0)
+}
+
+// brief: Reports whether every entry below the diagonal is zero
+//
+// note: m needn't be square, a wide upper trapezoidal
+//       Matrix such as R from QR() counts
+func (m *Matrix) IsUpperTriangular() bool {
+	return m.IsBanded(0, m.numCols)
+}
+
+// brief: Reports whether every entry above the diagonal is zero
+//
+// note: m needn't be square
+func (m *Matrix) IsLowerTriangular() bool {
+	return m.IsBanded(m.numRows, 0)
+}
+
+// brief: Reports whether m is zero outside a band
+//
+// details: The band is kl subdiagonals and ku superdiagonals
+//          around the main one, so a_ij may be nonzero only
+//          when -kl <= j - i <= ku. IsBanded(1, 1) is a
+//          tridiagonal Matrix.
+//
+// returns: true if every entry outside the band is zero,
+//          false for a negative kl or ku
+func (m *Matrix) IsBanded(kl, ku int) bool {
+	if kl < 0 || ku < 0 {
+		return false
+	}
+	for i := 0; i < m.numRows; i++ {
+		for j, v := range m.rowView(i) {
+			if v != 0 && (j < i-kl || j > i+ku) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// brief: Reports whether the columns of m are orthonormal
+//
+// details: Checks m^T m = I, which for a square m also
+//          means m m^T = I. O(n^3)
+//
+// inputs: tol, the largest deviation allowed in any
+//         entry of m^T m
+//
+// returns: true if m is square and orthogonal within tol
+func (m *Matrix) IsOrthogonal(tol float64) bool {
+	if !m.IsSquare() {
+		return false
+	}
+
+	gram, _ := m.T().Multiply(m)
+	for i := 0; i < gram.numRows; i++ {
+		for j, v := range gram.rowView(i) {
+			if i == j {
+				v--
+			}
+			if !(math.Abs(v) <= tol) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// brief: Reports whether m is symmetric positive definite
+//
+// details: Attempts a Cholesky factorization, which succeeds
+//          exactly when every pivot is positive. O(n^3/3)
+//
+// note: m has to be exactly symmetric, see IsSymmetric()
+func (m *Matrix) IsPositiveDefinite() bool {
+	if !m.IsSymmetric(0) {
+		return false
+	}
+	_, err := m.Cholesky()
+
+	return err == nil
+}
+
+// brief: Reports whether m is a permutation Matrix
+//
+// returns: true if m is square and every row and column
+//          holds a single 1 with zeros elsewhere
+func (m *Matrix) IsPermutation() bool {
+	if !m.IsSquare() {
+		return false
+	}
+
+	seen := make([]bool, m.numCols)
+	for i := 0; i < m.numRows; i++ {
+		one := -1
+		for j, v := range m.rowView(i) {
+			switch {
+			case v == 0:
+			case v == 1 && one < 0 && !seen[j]:
+				one = j
+			default:
+				return false
+			}
+		}
+		if one < 0 {
+			return false
+		}
+		seen[one] = true
+	}
+
+	return true
+}
+
+// brief: Calculates the trace of a Matrix
+//
+// returns: the sum of the diagonal entries, or
+//          ErrNotSquare
+func (m *Matrix) Trace() (float64, error) {
+	if !m.IsSquare() {
+		return 0, ErrNotSquare
+	}
+
+	trace := 0.0
+	for i := 0; i < m.numRows; i++ {
+		trace += m.data[i*m.stride+i]
+	}
+
+	return trace, nil
+}
+
+// brief: Copies the diagonal of a Matrix into a Vector
+//
+// note: m needn't be square, the diagonal has
+//       min(rows, cols) entries
+//
+// returns: a pointer to the Vector
+func (m *Matrix) Diag() *Vector {
+	d := BlankVector(min(m.numRows, m.numCols))
+	for i := range d.elems {
+		d.elems[i] = m.data[i*m.stride+i]
+	}
+
+	return d
+}
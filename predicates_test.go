@@ -0,0 +1,108 @@
+package golinal
+
+import (
+    "github.com/stretchr/testify/suite";
+    "math";
+    "testing"
+)
+
+//*************************************
+// Structural Predicates Test Suite
+//*************************************
+
+type PredicatesTestSuite struct {
+    suite.Suite
+
+    Tridiagonal *Matrix
+    Upper *Matrix
+}
+
+func (suite *PredicatesTestSuite) SetupTest() {
+    suite.Tridiagonal = MustMatrix(
+        []float64{4, -1, 0},
+        []float64{-1, 4, -1},
+        []float64{0, -1, 4},
+    )
+    suite.Upper = MustMatrix(
+        []float64{1, 2, 3},
+        []float64{0, 4, 5},
+    )
+}
+
+func (suite *PredicatesTestSuite) TestIsSquare() {
+    suite.True(ThreeIdentity.IsSquare(), "It should be square")
+    suite.False(NonsquareMatrix.IsSquare(), "It shouldn't be square")
+    suite.Equal(NonsquareMatrix.IsSquare(), NonsquareMatrix.IsSqaure(), "The deprecated name should agree")
+}
+
+func (suite *PredicatesTestSuite) TestIsSymmetric() {
+    suite.True(suite.Tridiagonal.IsSymmetric(0), "It should be symmetric")
+    suite.Tridiagonal.Set(0, 1, -1+1e-12)
+    suite.False(suite.Tridiagonal.IsSymmetric(0), "It shouldn't be exactly symmetric")
+    suite.True(suite.Tridiagonal.IsSymmetric(1e-10), "It should be symmetric within tol")
+    suite.False(NonsquareMatrix.IsSymmetric(1), "A non-square Matrix isn't symmetric")
+
+    nan := ThreeIdentity.Copy()
+    nan.Set(0, 2, math.NaN())
+    suite.False(nan.IsSymmetric(1), "NaN shouldn't compare within tol")
+}
+
+func (suite *PredicatesTestSuite) TestBanded() {
+    suite.True(suite.Tridiagonal.IsBanded(1, 1), "It should be tridiagonal")
+    suite.False(suite.Tridiagonal.IsBanded(0, 1), "It has a subdiagonal")
+    suite.False(suite.Tridiagonal.IsBanded(-1, 1), "A negative band is never satisfied")
+    suite.False(suite.Tridiagonal.IsDiagonal(), "It shouldn't be diagonal")
+    suite.True(ThreeIdentity.IsDiagonal(), "It should be diagonal")
+
+    suite.True(suite.Upper.IsUpperTriangular(), "It should be upper triangular")
+    suite.False(suite.Upper.IsLowerTriangular(), "It shouldn't be lower triangular")
+    suite.True(suite.Upper.T().IsLowerTriangular(), "It should be lower triangular")
+    suite.True(ThreeIdentity.IsUpperTriangular() && ThreeIdentity.IsLowerTriangular(), "It should be both")
+}
+
+func (suite *PredicatesTestSuite) TestIsOrthogonal() {
+    c, s := math.Cos(0.3), math.Sin(0.3)
+    rot := MustMatrix(
+        []float64{c, -s},
+        []float64{s, c},
+    )
+    suite.True(rot.IsOrthogonal(1e-14), "It should be orthogonal")
+    suite.True(ThreeIdentity.IsOrthogonal(0), "It should be orthogonal")
+    rot.Scale(2)
+    suite.False(rot.IsOrthogonal(1e-14), "It shouldn't be orthogonal")
+    suite.False(NonsquareMatrix.IsOrthogonal(1), "A non-square Matrix isn't orthogonal")
+}
+
+func (suite *PredicatesTestSuite) TestIsPositiveDefinite() {
+    suite.True(suite.Tridiagonal.IsPositiveDefinite(), "It should be positive definite")
+    suite.Tridiagonal.Scale(-1)
+    suite.False(suite.Tridiagonal.IsPositiveDefinite(), "It should be negative definite")
+    suite.False(suite.Upper.IsPositiveDefinite(), "A non-square Matrix isn't positive definite")
+    suite.False(MustMatrix([]float64{2, 1}, []float64{0, 2}).IsPositiveDefinite(), "It isn't symmetric")
+}
+
+func (suite *PredicatesTestSuite) TestIsPermutation() {
+    lu, _ := RandFourMatrix.LUP()
+    suite.True(lu.P().IsPermutation(), "It should be a permutation")
+    suite.True(ThreeIdentity.IsPermutation(), "It should be a permutation")
+    suite.False(suite.Tridiagonal.IsPermutation(), "It shouldn't be a permutation")
+    suite.False(MustMatrix([]float64{1, 0}, []float64{1, 0}).IsPermutation(), "A column holds two ones")
+    suite.False(MustMatrix([]float64{1, 1}, []float64{0, 0}).IsPermutation(), "A row holds two ones")
+    suite.False(NonsquareMatrix2.IsPermutation(), "A non-square Matrix isn't a permutation")
+}
+
+func (suite *PredicatesTestSuite) TestTraceDiag() {
+    trace, err := suite.Tridiagonal.Trace()
+    suite.Equal(err, nil, "There should be no error")
+    suite.Equal(12.0, trace, "They should be equal")
+    _, err = suite.Upper.Trace()
+    suite.Equal(ErrNotSquare, err, "They should be equal")
+
+    suite.Equal([]float64{1, 4}, suite.Upper.Diag().Slice(), "They should be equal")
+    v, _ := suite.Tridiagonal.Slice(1, 3, 0, 2)
+    suite.Equal([]float64{-1, -1}, v.Diag().Slice(), "Views should use their own diagonal")
+}
+
+func TestPredicates(t *testing.T) {
+    suite.Run(t, new(PredicatesTestSuite))
+}
@@ -0,0 +1,301 @@
+package golinal
+
+import (
+	"math"
+)
+
+// QR Struct Definition
+//
+// details: Holds the factorization AP = QR of an m x n Matrix A
+//          computed with Householder reflections, k = min(m, n).
+//          R is stored on and above the diagonal, and the
+//          essential part of the j'th Householder vector below
+//          it, as in LAPACK's dgeqrf/dgeqp3. P is the identity
+//          unless the factorization was column-pivoted.
+type QR struct {
+	qr    *Matrix
+	tau   []float64
+	perm  []int
+	pivot bool
+}
+
+// brief: Calculates the QR decomposition of a Matrix
+//
+// details: Householder reflections, O(2mn^2 - 2n^3/3).
+//          Works for any shape. The receiver is not modified.
+//
+// returns: the factorization of m
+func (m *Matrix) QR() *QR {
+	return householderQR(m, false)
+}
+
+// brief: Calculates the column-pivoted QR decomposition
+//
+// details: At each step the remaining column with the largest
+//          norm is moved to the front, so |r_11| >= |r_22| >= ...
+//          and the numerical rank can be read off R. Use this
+//          when A may be rank deficient.
+//
+// returns: the factorization of m
+func (m *Matrix) QRPivot() *QR {
+	return householderQR(m, true)
+}
+
+// brief: Shared body of QR() and QRPivot()
+func householderQR(m *Matrix, pivot bool) *QR {
+	rows, cols := m.numRows, m.numCols
+	k := min(rows, cols)
+	a := m.copy()
+
+	tau := make([]float64, k)
+	perm := make([]int, cols)
+	for j := range perm {
+		perm[j] = j
+	}
+
+	work := make([]float64, cols)
+	for j := 0; j < k; j++ {
+
+		// Bring the trailing column with the largest norm to j
+		if pivot {
+			p, largest := j, -1.0
+			for c := j; c < cols; c++ {
+				norm := 0.0
+				for i := j; i < rows; i++ {
+					norm += a.At(i, c) * a.At(i, c)
+				}
+				if norm > largest {
+					p, largest = c, norm
+				}
+			}
+			if p != j {
+				for i := 0; i < rows; i++ {
+					row := a.rowView(i)
+					row[j], row[p] = row[p], row[j]
+				}
+				perm[j], perm[p] = perm[p], perm[j]
+			}
+		}
+
+		// Build the reflector H = I - tau v v^T that
+		// zeroes column j below the diagonal, v_0 = 1
+		alpha := a.At(j, j)
+		norm := 0.0
+		for i := j + 1; i < rows; i++ {
+			norm = math.Hypot(norm, a.At(i, j))
+		}
+		if norm == 0 {
+			continue
+		}
+
+		beta := -math.Copysign(math.Hypot(alpha, norm), alpha)
+		tau[j] = (beta - alpha) / beta
+		for i := j + 1; i < rows; i++ {
+			a.data[i*a.stride+j] /= alpha - beta
+		}
+		a.data[j*a.stride+j] = beta
+
+		// Apply H to the trailing columns, a row at a time:
+		// w = v^T A, A = A - tau v w
+		w := work[j+1:]
+		copy(w, a.rowView(j)[j+1:])
+		for i := j + 1; i < rows; i++ {
+			row := a.rowView(i)
+			axpy(row[j], row[j+1:], w)
+		}
+		axpy(-tau[j], w, a.rowView(j)[j+1:])
+		for i := j + 1; i < rows; i++ {
+			row := a.rowView(i)
+			axpy(-tau[j]*row[j], w, row[j+1:])
+		}
+	}
+
+	return &QR{qr: a, tau: tau, perm: perm, pivot: pivot}
+}
+
+// brief: Gets the orthogonal factor
+//
+// details: The thin factor, an m x min(m, n) Matrix
+//          with orthonormal columns
+//
+// returns: Q as a new Matrix
+func (f *QR) Q() *Matrix {
+	return f.q(len(f.tau))
+}
+
+// brief: Gets the first cols columns of the full m x m
+//        orthogonal factor
+func (f *QR) q(cols int) *Matrix {
+	rows := f.qr.numRows
+	k := len(f.tau)
+
+	// Apply the reflectors to the first cols columns
+	// of I, last one first
+	Q := BlankMatrix(rows, cols)
+	col := make([]float64, rows)
+	for c := 0; c < cols; c++ {
+		for i := range col {
+			col[i] = 0
+		}
+		col[c] = 1
+		for j := k - 1; j >= 0; j-- {
+			f.applyReflector(j, col)
+		}
+		for i := range col {
+			Q.data[i*Q.stride+c] = col[i]
+		}
+	}
+
+	return Q
+}
+
+// brief: Gets the upper triangular factor
+//
+// returns: R as a new min(m, n) x n Matrix
+func (f *QR) R() *Matrix {
+	k := len(f.tau)
+	R := BlankMatrix(k, f.qr.numCols)
+	for i := 0; i < k; i++ {
+		copy(R.rowView(i)[i:], f.qr.rowView(i)[i:])
+	}
+
+	return R
+}
+
+// brief: Gets the column permutation Matrix
+//
+// returns: P as a new Matrix such that AP = QR
+func (f *QR) P() *Matrix {
+	n := len(f.perm)
+	P := BlankMatrix(n, n)
+	for j, p := range f.perm {
+		P.data[p*P.stride+j] = 1
+	}
+
+	return P
+}
+
+// brief: Gets the column pivot vector
+//
+// details: column j of AP is column Pivot()[j] of A
+//
+// returns: a copy of the pivot indices
+func (f *QR) Pivot() []int {
+	perm := make([]int, len(f.perm))
+	copy(perm, f.perm)
+
+	return perm
+}
+
+// brief: Estimates the numerical rank from the diagonal of R
+//
+// inputs: tol, entries |r_ii| <= tol count as zero. With
+//         tol <= 0, max(m, n) * eps * |r_11| is used
+//
+// note: only reliable for a factorization from QRPivot()
+//
+// returns: the number of |r_ii| above tol
+func (f *QR) Rank(tol float64) int {
+	tol = f.tolerance(tol)
+
+	rank := 0
+	for i := range f.tau {
+		if math.Abs(f.qr.At(i, i)) > tol {
+			rank++
+		}
+	}
+
+	return rank
+}
+
+// brief: Resolves the default tolerance of Rank()
+func (f *QR) tolerance(tol float64) float64 {
+	if tol > 0 {
+		return tol
+	}
+
+	largest := 0.0
+	for i := range f.tau {
+		largest = math.Max(largest, math.Abs(f.qr.At(i, i)))
+	}
+
+	return float64(max(f.qr.numRows, f.qr.numCols)) * eps * largest
+}
+
+// brief: Finds the x minimizing ||Ax - b||_2
+//
+// inputs: b a slice of floats of length m
+//
+// details: Solves Rx = Q^Tb, O(mn). For a rank deficient A
+//          factorized with QRPivot() the basic solution, with
+//          n - rank entries set to zero, is returned.
+//
+// returns: x, an *ErrShape if A has fewer rows than
+//          columns or b has the wrong length, or an
+//          *ErrSingular if A is rank deficient and wasn't
+//          factorized with pivoting
+func (f *QR) SolveLeastSquares(b []float64) ([]float64, error) {
+	rows, cols := f.qr.numRows, f.qr.numCols
+	if len(b) != rows || rows < cols {
+		return nil, errShape("least squares", rows, cols, len(b), 1)
+	}
+
+	rank := f.Rank(0)
+	if rank < cols && !f.pivot {
+		return nil, &ErrSingular{Index: f.zeroPivot()}
+	}
+
+	// c = Q^T b
+	c := make([]float64, rows)
+	copy(c, b)
+	for j := range f.tau {
+		f.applyReflector(j, c)
+	}
+
+	// Solve R_11 z = c_1 by backwards substitution
+	z := c[:rank]
+	for i := rank - 1; i >= 0; i-- {
+		row := f.qr.rowView(i)
+		z[i] = (z[i] - dot(row[i+1:rank], z[i+1:])) / row[i]
+	}
+
+	x := make([]float64, cols)
+	for i, zi := range z {
+		x[f.perm[i]] = zi
+	}
+
+	return x, nil
+}
+
+// brief: Finds the first |r_ii| at or below the Rank() tolerance
+//
+// returns: i, or -1 if A has full column rank
+func (f *QR) zeroPivot() int {
+	tol := f.tolerance(0)
+	for i := range f.tau {
+		if math.Abs(f.qr.At(i, i)) <= tol {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// brief: Overwrites x with H_j x
+func (f *QR) applyReflector(j int, x []float64) {
+	if f.tau[j] == 0 {
+		return
+	}
+
+	// w = v^T x with v_0 = 1
+	w := x[j]
+	for i := j + 1; i < f.qr.numRows; i++ {
+		w += f.qr.At(i, j) * x[i]
+	}
+	w *= f.tau[j]
+
+	x[j] -= w
+	for i := j + 1; i < f.qr.numRows; i++ {
+		x[i] -= w * f.qr.At(i, j)
+	}
+}
@@ -0,0 +1,110 @@
+package golinal
+
+import (
+    "github.com/stretchr/testify/suite";
+    "testing"
+)
+
+//*****************************
+// QR Decomposition Test Suite
+//*****************************
+
+type QRDecompTestSuite struct {
+    suite.Suite
+
+    Tall,
+    RankDeficient *Matrix
+
+    TallRHS []float64
+}
+
+func (suite *QRDecompTestSuite) SetupTest() {
+    // Fitting y = 1 + 2t through noisy points
+    suite.Tall = MustMatrix(
+        []float64{1, 0},
+        []float64{1, 1},
+        []float64{1, 2},
+        []float64{1, 3})
+    suite.TallRHS = []float64{1.1, 2.9, 5.1, 6.9}
+
+    // Third column is the sum of the first two
+    suite.RankDeficient = MustMatrix(
+        []float64{1, 0, 1},
+        []float64{0, 1, 1},
+        []float64{1, 1, 2},
+        []float64{2, 1, 3})
+}
+
+// Q has orthonormal columns, R is upper triangular
+// and together they give back A
+func (suite *QRDecompTestSuite) TestFactors() {
+    for _, qr := range []*QR{RandMatrix.QR(), RandMatrix.QRPivot(), suite.Tall.QR()} {
+        Q, R := qr.Q(), qr.R()
+
+        QtQ, _ := Q.Transpose().Multiply(Q)
+        matrixInDelta(&suite.Suite, Identity(Q.NumCols()), QtQ, 1e-12)
+
+        for i := 0; i < R.NumRows(); i++ {
+            for j := 0; j < i; j++ {
+                suite.Equal(0.0, R.At(i, j), "R should be upper triangular")
+            }
+        }
+    }
+
+    for _, A := range []*Matrix{RandMatrix, suite.Tall, suite.RankDeficient} {
+        qr := A.QRPivot()
+        QR, _ := qr.Q().Multiply(qr.R())
+        AP, _ := A.Multiply(qr.P())
+        matrixInDelta(&suite.Suite, AP, QR, 1e-12)
+    }
+}
+
+func (suite *QRDecompTestSuite) TestRank() {
+    suite.Equal(10, RandMatrix.QRPivot().Rank(0), "They should be equal")
+    suite.Equal(2, suite.Tall.QRPivot().Rank(0), "They should be equal")
+    suite.Equal(2, suite.RankDeficient.QRPivot().Rank(0), "They should be equal")
+}
+
+func (suite *QRDecompTestSuite) TestLeastSquares() {
+    x, err := suite.Tall.QR().SolveLeastSquares(suite.TallRHS)
+    suite.Equal(nil, err, "There should be no error")
+    suite.InDeltaSlice([]float64{1.06, 1.96}, x, 1e-12)
+
+    // Gauss falls back to least squares on non square matrices
+    x, err = suite.Tall.Gauss(suite.TallRHS)
+    suite.Equal(nil, err, "There should be no error")
+    suite.InDeltaSlice([]float64{1.06, 1.96}, x, 1e-12)
+
+    _, err = suite.Tall.QR().SolveLeastSquares([]float64{1, 2})
+    suite.NotEqual(nil, err, "There should be an error")
+
+    _, err = suite.Tall.Transpose().QR().SolveLeastSquares([]float64{1, 2})
+    suite.NotEqual(nil, err, "Underdetermined systems aren't supported")
+}
+
+// Without pivoting a rank deficient A is refused, with
+// pivoting a basic solution of the consistent system is found
+func (suite *QRDecompTestSuite) TestRankDeficient() {
+    b := []float64{2, 3, 5, 7}
+
+    _, err := suite.RankDeficient.QR().SolveLeastSquares(b)
+    suite.NotEqual(nil, err, "There should be an error")
+
+    x, err := suite.RankDeficient.QRPivot().SolveLeastSquares(b)
+    suite.Equal(nil, err, "There should be no error")
+
+    zeros := 0
+    for _, xi := range x {
+        if xi == 0 {
+            zeros++
+        }
+    }
+    suite.Equal(1, zeros, "Basic solution has n - rank zero entries")
+
+    Ax, _ := suite.RankDeficient.MulVec(NewVector(x...))
+    suite.InDeltaSlice(b, Ax.Slice(), 1e-12)
+}
+
+func TestQR(t *testing.T) {
+    suite.Run(t, new(QRDecompTestSuite))
+}
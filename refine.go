@@ -0,0 +1,268 @@
+package golinal
+
+import (
+	"math"
+)
+
+// Default number of refinement steps
+const defaultRefineIter = 10
+
+// RefineOptions Struct Definition
+//
+// details: Settings for GaussRefined() and LU.SolveRefined().
+//          Zero values pick the defaults: Tol eps, MaxIter 10
+//          and a float64 factorization.
+type RefineOptions struct {
+	// Stop once the normwise backward error
+	// ||b - Ax|| / (||A|| ||x|| + ||b||), in the
+	// infinity norm, is <= Tol
+	Tol float64
+
+	// Refinement steps allowed after the first solve
+	MaxIter int
+
+	// GaussRefined() only, factorize in float32 and
+	// refine in float64. Half the memory and twice the
+	// speed of the factorization, and still accurate to
+	// float64 precision when A is well enough conditioned
+	// (cond(A) well below 1e7). Ignored, and A factorized
+	// in float64, when an entry of A is Inf, NaN, or
+	// nonzero with a magnitude outside float32's normal
+	// range, about 1.2e-38 to 3.4e38, as converting it
+	// would overflow or lose digits
+	Float32 bool
+}
+
+// RefineResult Struct Definition
+//
+// details: The outcome of a refined solve. Residual is
+//          ||b - Ax|| and BackwardError the quantity compared
+//          against Tol, both for the returned X.
+type RefineResult struct {
+	X             []float64
+	Iterations    int
+	Residual      float64
+	BackwardError float64
+	Converged     bool
+}
+
+// brief: Solves Ax = b with iterative refinement
+//
+// details: After the LU solve, the residual r = b - Ax is
+//          computed in twice working precision by compensated
+//          summation, the correction Ad = r is solved with the
+//          same factorization and x += d. Each step costs
+//          O(n^2), so an accurate solution comes for a small
+//          fraction of the O(n^3) factorization. Refinement
+//          stops at Tol, after MaxIter steps, or as soon as a
+//          step fails to halve the backward error.
+//
+// inputs: b a slice of floats of length n, opts may be
+//         nil for the defaults
+//
+// returns: the result, ErrNotSquare, an *ErrSingular if A
+//          is singular, an *ErrShape if b has the wrong
+//          length, or ErrNotConverged along with the best
+//          solution found if Tol wasn't reached
+func (A *Matrix) GaussRefined(b []float64, opts *RefineOptions) (*RefineResult, error) {
+	if !A.IsSquare() {
+		return nil, ErrNotSquare
+	}
+	n := A.numRows
+	if len(b) != n {
+		return nil, errShape("solve", n, n, len(b), 1)
+	}
+
+	if opts == nil || !opts.Float32 || !fitsFloat32(A) {
+		lu, err := A.LUP()
+		if err != nil {
+			return nil, err
+		}
+		return lu.SolveRefined(A, b, opts)
+	}
+
+	single := BlankDense[float32](n, n)
+	for i := 0; i < n; i++ {
+		row := single.rowView(i)
+		for j, v := range A.rowView(i) {
+			row[j] = float32(v)
+		}
+	}
+	lu, err := DenseLUP(single)
+	if err != nil {
+		return nil, err
+	}
+	if lu.IsSingular() {
+		return nil, &ErrSingular{Index: lu.zeroPivot}
+	}
+
+	// Scale r to unit size so a small residual doesn't
+	// underflow in float32
+	r32 := make([]float32, n)
+	solve := func(r []float64) []float64 {
+		scale := 0.0
+		for _, v := range r {
+			scale = math.Max(scale, math.Abs(v))
+		}
+		d := make([]float64, n)
+		if scale == 0 {
+			return d
+		}
+		for i, v := range r {
+			r32[i] = float32(v / scale)
+		}
+		x32, _ := lu.Solve(r32)
+		for i, v := range x32 {
+			d[i] = scale * float64(v)
+		}
+		return d
+	}
+
+	result := refine(A, b, solve, opts)
+
+	return result, refineErr(result)
+}
+
+// brief: Solves Ax = b with iterative refinement, reusing
+//        the factorization
+//
+// details: As GaussRefined(), A has to be the Matrix that
+//          was factorized, it supplies the residuals
+//
+// returns: the result, an *ErrSingular if A is singular, an
+//          *ErrShape if A or b don't match the factorization,
+//          or ErrNotConverged along with the best solution
+//          found if Tol wasn't reached
+func (f *LU) SolveRefined(A *Matrix, b []float64, opts *RefineOptions) (*RefineResult, error) {
+	n := f.lu.numRows
+	if A.numRows != n || A.numCols != n {
+		return nil, errShape("refine", n, n, A.numRows, A.numCols)
+	}
+	if len(b) != n {
+		return nil, errShape("solve", n, n, len(b), 1)
+	}
+	if f.IsSingular() {
+		return nil, &ErrSingular{Index: f.zeroPivot}
+	}
+
+	result := refine(A, b, f.solvePermuted, opts)
+
+	return result, refineErr(result)
+}
+
+///////////////////////////////
+//         HELPER            //
+//         FUNCTIONS         //
+///////////////////////////////
+
+// brief: Runs the refinement loop
+//
+// inputs: solve, returns the solution of A d = r from
+//         the factorization
+func refine(A *Matrix, b []float64, solve func(r []float64) []float64, opts *RefineOptions) *RefineResult {
+	tol, maxIter := eps, defaultRefineIter
+	if opts != nil {
+		if opts.Tol > 0 {
+			tol = opts.Tol
+		}
+		if opts.MaxIter > 0 {
+			maxIter = opts.MaxIter
+		}
+	}
+
+	normA := A.Norm(NormInf)
+	normB := 0.0
+	for _, v := range b {
+		normB = math.Max(normB, math.Abs(v))
+	}
+
+	x := solve(b)
+	r := make([]float64, len(b))
+	result := &RefineResult{}
+	measure := func(x []float64) (float64, float64) {
+		normR, normX := 0.0, 0.0
+		for i := range r {
+			r[i] = residual2(b[i], A.rowView(i), x)
+			normR = math.Max(normR, math.Abs(r[i]))
+			normX = math.Max(normX, math.Abs(x[i]))
+		}
+		if denom := normA*normX + normB; denom > 0 {
+			return normR, normR / denom
+		}
+		return normR, 0
+	}
+	result.X = x
+	result.Residual, result.BackwardError = measure(x)
+
+	for result.BackwardError > tol && result.Iterations < maxIter {
+		d := solve(r)
+		next := make([]float64, len(x))
+		for i := range next {
+			next[i] = x[i] + d[i]
+		}
+
+		// A step that doesn't help is dropped, one that
+		// helps too little is the last
+		normR, berr := measure(next)
+		if !(berr < result.BackwardError) {
+			break
+		}
+		halved := berr <= result.BackwardError/2
+		x = next
+		result.X, result.Residual, result.BackwardError = x, normR, berr
+		result.Iterations++
+		if !halved {
+			break
+		}
+	}
+	result.Converged = result.BackwardError <= tol
+
+	return result
+}
+
+// brief: Reports whether every entry of m converts to a
+//        normal float32, or zero, without overflowing
+func fitsFloat32(m *Matrix) bool {
+	for i := 0; i < m.numRows; i++ {
+		for _, v := range m.rowView(i) {
+			if a := math.Abs(v); a != 0 && !(a >= 0x1p-126 && a <= math.MaxFloat32) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// brief: Picks the error to return with a result
+//
+// returns: ErrNotConverged if result didn't converge
+func refineErr(result *RefineResult) error {
+	if !result.Converged {
+		return ErrNotConverged
+	}
+
+	return nil
+}
+
+// brief: Computes b - a·x as if in twice working precision
+//
+// details: Ogita, Rump and Oishi's Dot2. Each product is
+//          split exactly into a float64 and its rounding error
+//          with FMA, each sum with Knuth's TwoSum, and the
+//          errors are added back at the end. The residual of an
+//          accurate x is mostly cancellation, which plain
+//          float64 summation would turn into noise.
+func residual2(b float64, a, x []float64) float64 {
+	s, c := b, 0.0
+	for i, v := range a {
+		p := -v * x[i]
+		pErr := math.FMA(-v, x[i], -p)
+		t := s + p
+		z := t - s
+		c += (s - (t - z)) + (p - z) + pErr
+		s = t
+	}
+
+	return s + c
+}
@@ -0,0 +1,118 @@
+package golinal
+
+import (
+    "github.com/stretchr/testify/suite";
+    "errors";
+    "math";
+    "testing"
+)
+
+//*************************************
+// Iterative Refinement Test Suite
+//*************************************
+
+type RefineTestSuite struct {
+    suite.Suite
+
+    A *Matrix
+    X, B []float64
+}
+
+func (suite *RefineTestSuite) SetupTest() {
+    suite.A = randomMatrix(40, 40, 7)
+    for i := 0; i < 40; i++ {
+        suite.A.Set(i, i, suite.A.At(i, i)+10)
+    }
+    suite.X = make([]float64, 40)
+    for i := range suite.X {
+        suite.X[i] = math.Sin(float64(i))
+    }
+    // b = Ax, accurately
+    suite.B = make([]float64, 40)
+    for i := range suite.B {
+        suite.B[i] = -residual2(0, suite.A.rowView(i), suite.X)
+    }
+}
+
+func (suite *RefineTestSuite) TestGaussRefined() {
+    result, err := suite.A.GaussRefined(suite.B, nil)
+    suite.Equal(err, nil, "There should be no error")
+    suite.True(result.Converged, "It should converge")
+    suite.LessOrEqual(result.BackwardError, eps, "The backward error should be at most eps")
+    suite.InDeltaSlice(suite.X, result.X, 1e-13, "They should be equal")
+}
+
+func (suite *RefineTestSuite) TestFloat32() {
+    result, err := suite.A.GaussRefined(suite.B, &RefineOptions{Float32: true})
+    suite.Equal(err, nil, "There should be no error")
+    suite.True(result.Converged, "It should converge")
+    suite.Greater(result.Iterations, 0, "Refinement should be needed")
+    suite.InDeltaSlice(suite.X, result.X, 1e-13, "It should reach float64 accuracy")
+
+    // One step can't make up for float32
+    result, err = suite.A.GaussRefined(suite.B, &RefineOptions{Float32: true, MaxIter: 1})
+    suite.Equal(ErrNotConverged, err, "They should be equal")
+    suite.Equal(1, result.Iterations, "They should be equal")
+    suite.False(result.Converged, "It shouldn't converge")
+    suite.NotNil(result.X, "The best solution should be returned")
+}
+
+// Entries float32 can't hold fall back to float64
+func (suite *RefineTestSuite) TestFloat32Range() {
+    for _, scale := range []float64{1e40, 1e-40} {
+        A := suite.A.copy()
+        for i := 0; i < A.numRows; i++ {
+            scal(scale, A.rowView(i))
+        }
+        b := make([]float64, len(suite.B))
+        for i, v := range suite.B {
+            b[i] = scale * v
+        }
+
+        result, err := A.GaussRefined(b, &RefineOptions{Float32: true})
+        suite.Equal(err, nil, "There should be no error")
+        suite.True(result.Converged, "It should converge")
+        suite.InDeltaSlice(suite.X, result.X, 1e-13, "They should be equal")
+    }
+
+    suite.True(fitsFloat32(suite.A), "suite.A should fit")
+    suite.False(fitsFloat32(MustMatrix([]float64{1, math.Inf(1)})), "Inf shouldn't fit")
+    suite.False(fitsFloat32(MustMatrix([]float64{1, math.NaN()})), "NaN shouldn't fit")
+    suite.True(fitsFloat32(MustMatrix([]float64{0, -math.MaxFloat32})), "The edges should fit")
+}
+
+func (suite *RefineTestSuite) TestSolveRefined() {
+    lu, _ := suite.A.LUP()
+    result, err := lu.SolveRefined(suite.A, suite.B, &RefineOptions{Tol: 1e-10})
+    suite.Equal(err, nil, "There should be no error")
+    suite.LessOrEqual(result.BackwardError, 1e-10, "The backward error should be below Tol")
+    suite.Less(result.Residual, 1e-10, "The residual should be small")
+
+    _, err = lu.SolveRefined(ThreeIdentity, suite.B, nil)
+    suite.True(errors.Is(err, &ErrShape{}), "Error should match ErrShape")
+    _, err = lu.SolveRefined(suite.A, suite.B[:3], nil)
+    suite.True(errors.Is(err, &ErrShape{}), "Error should match ErrShape")
+}
+
+func (suite *RefineTestSuite) TestErrors() {
+    _, err := NonsquareMatrix.GaussRefined([]float64{1, 2}, nil)
+    suite.Equal(ErrNotSquare, err, "They should be equal")
+
+    singular := BlankMatrix(2, 2)
+    _, err = singular.GaussRefined([]float64{1, 2}, nil)
+    suite.True(errors.Is(err, &ErrSingular{}), "Error should match ErrSingular")
+    _, err = singular.GaussRefined([]float64{1, 2}, &RefineOptions{Float32: true})
+    suite.True(errors.Is(err, &ErrSingular{}), "Error should match ErrSingular")
+}
+
+func (suite *RefineTestSuite) TestResidual() {
+    // 1e16 + 1 - 1e16 loses the 1 in plain float64
+    a := []float64{1e16, 1, -1e16}
+    x := []float64{1, 1, 1}
+    suite.Equal(-1.0, residual2(0, a, x), "They should be equal")
+    suite.Equal(2.0, residual2(3, a, x), "They should be equal")
+}
+
+func TestRefine(t *testing.T) {
+    suite.Run(t, new(RefineTestSuite))
+}
@@ -0,0 +1,438 @@
+package golinal
+
+import (
+	"math"
+	"sort"
+)
+
+// COO Struct Definition
+//
+// details: Coordinate format, a list of (i, j, v) triplets.
+//          Cheap to append to, so it's the format to assemble
+//          a sparse Matrix in before converting it with ToCSR()
+//          or ToCSC(). Duplicate entries are summed then.
+type COO struct {
+	rows, cols int
+	rowIdx     []int
+	colIdx     []int
+	vals       []float64
+}
+
+// CSR Struct Definition
+//
+// details: Compressed sparse row format. The entries of row i
+//          are data[indptr[i]:indptr[i+1]], in increasing column
+//          order, with their columns in indices.
+type CSR struct {
+	rows, cols int
+	indptr     []int
+	indices    []int
+	data       []float64
+}
+
+// CSC Struct Definition
+//
+// details: Compressed sparse column format, the transpose of
+//          CSR. The entries of column j are data[indptr[j]:indptr[j+1]],
+//          in increasing row order, with their rows in indices.
+type CSC struct {
+	rows, cols int
+	indptr     []int
+	indices    []int
+	data       []float64
+}
+
+// brief: Constructor for an empty COO Matrix
+//
+// returns: a pointer to a rows x cols COO with no entries
+func NewCOO(rows, cols int) *COO {
+	return &COO{rows: rows, cols: cols}
+}
+
+// brief: Adds v to entry (i, j)
+//
+// details: Amortized O(1). Nothing is merged until
+//          conversion, so appending to the same entry
+//          twice accumulates, as finite-element assembly
+//          expects.
+//
+// returns: an *ErrIndexOutOfRange if (i, j) isn't inside
+//          the Matrix, in which case nothing is appended
+func (c *COO) Append(i, j int, v float64) error {
+	if i < 0 || i >= c.rows || j < 0 || j >= c.cols {
+		return &ErrIndexOutOfRange{Row: i, Col: j, Rows: c.rows, Cols: c.cols}
+	}
+	c.rowIdx = append(c.rowIdx, i)
+	c.colIdx = append(c.colIdx, j)
+	c.vals = append(c.vals, v)
+
+	return nil
+}
+
+// brief: Gets the dimensions of a COO Matrix
+//
+// returns: the number of rows, the number of columns
+func (c *COO) Dims() (int, int) {
+	return c.rows, c.cols
+}
+
+// brief: Gets the number of stored triplets, duplicates included
+func (c *COO) NNZ() int {
+	return len(c.vals)
+}
+
+// brief: Converts to compressed sparse row format
+//
+// details: O(nnz log(nnz/rows)), duplicates are summed
+//
+// returns: a new CSR
+func (c *COO) ToCSR() *CSR {
+	indptr, indices, data := compress(c.rows, c.rowIdx, c.colIdx, c.vals)
+
+	return &CSR{rows: c.rows, cols: c.cols, indptr: indptr, indices: indices, data: data}
+}
+
+// brief: Converts to compressed sparse column format
+//
+// details: O(nnz log(nnz/cols)), duplicates are summed
+//
+// returns: a new CSC
+func (c *COO) ToCSC() *CSC {
+	indptr, indices, data := compress(c.cols, c.colIdx, c.rowIdx, c.vals)
+
+	return &CSC{rows: c.rows, cols: c.cols, indptr: indptr, indices: indices, data: data}
+}
+
+// brief: Converts to a dense Matrix
+//
+// returns: a new Matrix with duplicates summed
+func (c *COO) ToDense() *Matrix {
+	m := BlankMatrix(c.rows, c.cols)
+	for k, v := range c.vals {
+		m.data[c.rowIdx[k]*m.stride+c.colIdx[k]] += v
+	}
+
+	return m
+}
+
+// brief: Builds a CSR Matrix out of the entries of a dense one
+//
+// inputs: tol, entries with |a_ij| <= tol are dropped. Use
+//         0 to keep every nonzero
+//
+// returns: a new CSR
+func FromDense(m *Matrix, tol float64) *CSR {
+	s := &CSR{rows: m.numRows, cols: m.numCols, indptr: make([]int, m.numRows+1)}
+	for i := 0; i < m.numRows; i++ {
+		for j, v := range m.rowView(i) {
+			if math.Abs(v) > tol {
+				s.indices = append(s.indices, j)
+				s.data = append(s.data, v)
+			}
+		}
+		s.indptr[i+1] = len(s.data)
+	}
+
+	return s
+}
+
+///////////////////////////////
+//           CSR             //
+///////////////////////////////
+
+// brief: Gets the dimensions of a CSR Matrix
+//
+// returns: the number of rows, the number of columns
+func (s *CSR) Dims() (int, int) {
+	return s.rows, s.cols
+}
+
+// brief: Gets the number of stored entries
+func (s *CSR) NNZ() int {
+	return len(s.data)
+}
+
+// brief: Get the row,col'th entry
+//
+// details: Binary search within the row, O(log nnz_i)
+//
+// note: it is undefined behavior to use invalid indices with At()
+//
+// returns: A_ij, 0 if it isn't stored
+func (s *CSR) At(row, col int) float64 {
+	return lookup(s.indptr, s.indices, s.data, row, col)
+}
+
+// brief: Converts to a dense Matrix
+//
+// returns: a new Matrix
+func (s *CSR) ToDense() *Matrix {
+	m := BlankMatrix(s.rows, s.cols)
+	for i := 0; i < s.rows; i++ {
+		row := m.rowView(i)
+		for k := s.indptr[i]; k < s.indptr[i+1]; k++ {
+			row[s.indices[k]] = s.data[k]
+		}
+	}
+
+	return m
+}
+
+// brief: Converts to compressed sparse column format
+//
+// returns: a new CSC with the same entries
+func (s *CSR) ToCSC() *CSC {
+	indptr, indices, data := compress(s.cols, s.indices, expand(s.indptr), s.data)
+
+	return &CSC{rows: s.rows, cols: s.cols, indptr: indptr, indices: indices, data: data}
+}
+
+// brief: Calculates the transpose
+//
+// details: The rows of A are the columns of A^T, so this
+//          is O(1) and shares storage with s
+//
+// returns: A^T as a CSC
+func (s *CSR) T() *CSC {
+	return &CSC{rows: s.cols, cols: s.rows, indptr: s.indptr, indices: s.indices, data: s.data}
+}
+
+// brief: Multiplies the sparse Matrix s by the Vector v
+//
+// details: O(nnz)
+//
+// returns: the product sv, or an *ErrShape if the number
+//          of columns of s isn't the length of v
+func (s *CSR) MulVec(v *Vector) (*Vector, error) {
+	if s.cols != v.n {
+		return nil, errShape("multiply", s.rows, s.cols, v.n, 1)
+	}
+
+	result := BlankVector(s.rows)
+	for i := 0; i < s.rows; i++ {
+		sum := 0.0
+		for k := s.indptr[i]; k < s.indptr[i+1]; k++ {
+			sum += s.data[k] * v.elems[s.indices[k]]
+		}
+		result.elems[i] = sum
+	}
+
+	return result, nil
+}
+
+// brief: Multiplies the sparse Matrix s by the dense Matrix q
+//
+// details: O(nnz * q.NumCols())
+//
+// returns: the product sq as a dense Matrix, or an *ErrShape
+//          if s has a different number of columns than q has rows
+func (s *CSR) Multiply(q *Matrix) (*Matrix, error) {
+	if s.cols != q.numRows {
+		return nil, errShape("multiply", s.rows, s.cols, q.numRows, q.numCols)
+	}
+
+	result := BlankMatrix(s.rows, q.numCols)
+	for i := 0; i < s.rows; i++ {
+		row := result.rowView(i)
+		for k := s.indptr[i]; k < s.indptr[i+1]; k++ {
+			axpy(s.data[k], q.rowView(s.indices[k]), row)
+		}
+	}
+
+	return result, nil
+}
+
+///////////////////////////////
+//           CSC             //
+///////////////////////////////
+
+// brief: Gets the dimensions of a CSC Matrix
+//
+// returns: the number of rows, the number of columns
+func (s *CSC) Dims() (int, int) {
+	return s.rows, s.cols
+}
+
+// brief: Gets the number of stored entries
+func (s *CSC) NNZ() int {
+	return len(s.data)
+}
+
+// brief: Get the row,col'th entry
+//
+// details: Binary search within the column, O(log nnz_j)
+//
+// note: it is undefined behavior to use invalid indices with At()
+//
+// returns: A_ij, 0 if it isn't stored
+func (s *CSC) At(row, col int) float64 {
+	return lookup(s.indptr, s.indices, s.data, col, row)
+}
+
+// brief: Converts to a dense Matrix
+//
+// returns: a new Matrix
+func (s *CSC) ToDense() *Matrix {
+	m := BlankMatrix(s.rows, s.cols)
+	for j := 0; j < s.cols; j++ {
+		for k := s.indptr[j]; k < s.indptr[j+1]; k++ {
+			m.data[s.indices[k]*m.stride+j] = s.data[k]
+		}
+	}
+
+	return m
+}
+
+// brief: Converts to compressed sparse row format
+//
+// returns: a new CSR with the same entries
+func (s *CSC) ToCSR() *CSR {
+	indptr, indices, data := compress(s.rows, s.indices, expand(s.indptr), s.data)
+
+	return &CSR{rows: s.rows, cols: s.cols, indptr: indptr, indices: indices, data: data}
+}
+
+// brief: Calculates the transpose
+//
+// details: O(1), shares storage with s
+//
+// returns: A^T as a CSR
+func (s *CSC) T() *CSR {
+	return &CSR{rows: s.cols, cols: s.rows, indptr: s.indptr, indices: s.indices, data: s.data}
+}
+
+// brief: Multiplies the sparse Matrix s by the Vector v
+//
+// details: O(nnz)
+//
+// returns: the product sv, or an *ErrShape if the number
+//          of columns of s isn't the length of v
+func (s *CSC) MulVec(v *Vector) (*Vector, error) {
+	if s.cols != v.n {
+		return nil, errShape("multiply", s.rows, s.cols, v.n, 1)
+	}
+
+	result := BlankVector(s.rows)
+	for j := 0; j < s.cols; j++ {
+		vj := v.elems[j]
+		for k := s.indptr[j]; k < s.indptr[j+1]; k++ {
+			result.elems[s.indices[k]] += s.data[k] * vj
+		}
+	}
+
+	return result, nil
+}
+
+// brief: Multiplies the sparse Matrix s by the dense Matrix q
+//
+// details: O(nnz * q.NumCols())
+//
+// returns: the product sq as a dense Matrix, or an *ErrShape
+//          if s has a different number of columns than q has rows
+func (s *CSC) Multiply(q *Matrix) (*Matrix, error) {
+	if s.cols != q.numRows {
+		return nil, errShape("multiply", s.rows, s.cols, q.numRows, q.numCols)
+	}
+
+	result := BlankMatrix(s.rows, q.numCols)
+	for j := 0; j < s.cols; j++ {
+		qRow := q.rowView(j)
+		for k := s.indptr[j]; k < s.indptr[j+1]; k++ {
+			axpy(s.data[k], qRow, result.rowView(s.indices[k]))
+		}
+	}
+
+	return result, nil
+}
+
+///////////////////////////////
+//         HELPER            //
+//         FUNCTIONS         //
+///////////////////////////////
+
+// brief: Compresses triplets along their major index
+//
+// details: Counting sort on major, then each segment is
+//          sorted by minor and duplicates are summed
+//
+// returns: indptr of length n+1, and the minor indices
+//          and values of the merged entries
+func compress(n int, major, minor []int, vals []float64) ([]int, []int, []float64) {
+	indptr := make([]int, n+1)
+	for _, i := range major {
+		indptr[i+1]++
+	}
+	for i := 0; i < n; i++ {
+		indptr[i+1] += indptr[i]
+	}
+
+	next := make([]int, n)
+	copy(next, indptr)
+	indices := make([]int, len(vals))
+	data := make([]float64, len(vals))
+	for k, i := range major {
+		indices[next[i]] = minor[k]
+		data[next[i]] = vals[k]
+		next[i]++
+	}
+
+	// Sort each segment and merge duplicates, compacting
+	// the arrays as we go
+	nnz := 0
+	for i := 0; i < n; i++ {
+		start, end := indptr[i], indptr[i+1]
+		sort.Sort(segment{indices[start:end], data[start:end]})
+
+		indptr[i] = nnz
+		for k := start; k < end; k++ {
+			if nnz > indptr[i] && indices[nnz-1] == indices[k] {
+				data[nnz-1] += data[k]
+				continue
+			}
+			indices[nnz] = indices[k]
+			data[nnz] = data[k]
+			nnz++
+		}
+	}
+	indptr[n] = nnz
+
+	return indptr, indices[:nnz], data[:nnz]
+}
+
+// brief: Expands indptr back into one major index per entry
+func expand(indptr []int) []int {
+	major := make([]int, indptr[len(indptr)-1])
+	for i := 0; i+1 < len(indptr); i++ {
+		for k := indptr[i]; k < indptr[i+1]; k++ {
+			major[k] = i
+		}
+	}
+
+	return major
+}
+
+// brief: Finds entry (major, minor) of a compressed Matrix
+//
+// returns: the value, 0 if it isn't stored
+func lookup(indptr, indices []int, data []float64, major, minor int) float64 {
+	start, end := indptr[major], indptr[major+1]
+	k := start + sort.SearchInts(indices[start:end], minor)
+	if k < end && indices[k] == minor {
+		return data[k]
+	}
+
+	return 0
+}
+
+// segment sorts a run of compressed entries by minor index
+type segment struct {
+	indices []int
+	data    []float64
+}
+
+func (s segment) Len() int           { return len(s.indices) }
+func (s segment) Less(a, b int) bool { return s.indices[a] < s.indices[b] }
+func (s segment) Swap(a, b int) {
+	s.indices[a], s.indices[b] = s.indices[b], s.indices[a]
+	s.data[a], s.data[b] = s.data[b], s.data[a]
+}
@@ -0,0 +1,117 @@
+package golinal
+
+import (
+    "github.com/stretchr/testify/suite";
+    "errors";
+    "testing"
+)
+
+//*******************************
+// Sparse Matrix Test Suite
+//*******************************
+
+type SparseTestSuite struct {
+    suite.Suite
+
+    COO *COO
+    Dense *Matrix
+}
+
+// Entries appended out of order, with (1, 2) split in two
+// and an empty last row
+func (suite *SparseTestSuite) SetupTest() {
+    suite.COO = NewCOO(4, 3)
+    suite.COO.Append(1, 2, 1)
+    suite.COO.Append(0, 1, 2)
+    suite.COO.Append(2, 0, -3)
+    suite.COO.Append(1, 2, 4)
+    suite.COO.Append(0, 0, 5)
+    suite.COO.Append(2, 2, 6)
+
+    suite.Dense = MustMatrix(
+        []float64{ 5, 2, 0},
+        []float64{ 0, 0, 5},
+        []float64{-3, 0, 6},
+        []float64{ 0, 0, 0})
+}
+
+func (suite *SparseTestSuite) TestConversions() {
+    csr := suite.COO.ToCSR()
+    csc := suite.COO.ToCSC()
+
+    suite.Equal(6, suite.COO.NNZ(), "They should be equal")
+    suite.Equal(5, csr.NNZ(), "Duplicates should be summed")
+    suite.Equal(5, csc.NNZ(), "Duplicates should be summed")
+
+    suite.Equal(suite.Dense, suite.COO.ToDense(), "They should be equal")
+    suite.Equal(suite.Dense, csr.ToDense(), "They should be equal")
+    suite.Equal(suite.Dense, csc.ToDense(), "They should be equal")
+    suite.Equal(csc, csr.ToCSC(), "They should be equal")
+    suite.Equal(csr, csc.ToCSR(), "They should be equal")
+    suite.Equal(csr, FromDense(suite.Dense, 0), "They should be equal")
+
+    for i := 0; i < 4; i++ {
+        for j := 0; j < 3; j++ {
+            suite.Equal(suite.Dense.At(i, j), csr.At(i, j), "They should be equal")
+            suite.Equal(suite.Dense.At(i, j), csc.At(i, j), "They should be equal")
+        }
+    }
+
+    err := suite.COO.Append(4, 0, 1)
+    suite.True(errors.Is(err, &ErrIndexOutOfRange{}), "Error should match ErrIndexOutOfRange")
+    suite.Equal(6, suite.COO.NNZ(), "Nothing should be appended")
+}
+
+func (suite *SparseTestSuite) TestFromDenseTolerance() {
+    s := FromDense(suite.Dense, 4)
+
+    suite.Equal(3, s.NNZ(), "They should be equal")
+    suite.Equal(MustMatrix(
+        []float64{5, 0, 0},
+        []float64{0, 0, 5},
+        []float64{0, 0, 6},
+        []float64{0, 0, 0}), s.ToDense(), "They should be equal")
+}
+
+func (suite *SparseTestSuite) TestTranspose() {
+    csr := suite.COO.ToCSR()
+    csc := suite.COO.ToCSC()
+
+    suite.Equal(suite.Dense.T(), csr.T().ToDense(), "They should be equal")
+    suite.Equal(suite.Dense.T(), csc.T().ToDense(), "They should be equal")
+
+    r, c := csr.T().Dims()
+    suite.Equal([]int{3, 4}, []int{r, c}, "They should be equal")
+}
+
+func (suite *SparseTestSuite) TestProducts() {
+    csr := suite.COO.ToCSR()
+    csc := suite.COO.ToCSC()
+    B := randomMatrix(3, 5, 7)
+
+    expected, _ := suite.Dense.Multiply(B)
+    product, err := csr.Multiply(B)
+    suite.Equal(nil, err, "There should be no error")
+    matrixInDelta(&suite.Suite, expected, product, 1e-12)
+    product, err = csc.Multiply(B)
+    suite.Equal(nil, err, "There should be no error")
+    matrixInDelta(&suite.Suite, expected, product, 1e-12)
+
+    v := NewVector(1, -2, 3)
+    expectedVec, _ := suite.Dense.MulVec(v)
+    result, err := csr.MulVec(v)
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal(expectedVec, result, "They should be equal")
+    result, err = csc.MulVec(v)
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal(expectedVec, result, "They should be equal")
+
+    _, err = csr.Multiply(B.T())
+    suite.True(errors.Is(err, &ErrShape{}), "Error should match ErrShape")
+    _, err = csc.MulVec(NewVector(1))
+    suite.True(errors.Is(err, &ErrShape{}), "Error should match ErrShape")
+}
+
+func TestSparse(t *testing.T) {
+    suite.Run(t, new(SparseTestSuite))
+}
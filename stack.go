@@ -0,0 +1,154 @@
+package golinal
+
+import (
+	"fmt"
+)
+
+// brief: Joins matrices side by side, e.g. [A | b]
+//
+// returns: a pointer to the new Matrix, or an *ErrShape
+//          if the matrices don't all have the same number
+//          of rows
+func HStack(ms ...*Matrix) (*Matrix, error) {
+	return block("hstack", [][]*Matrix{ms})
+}
+
+// brief: Joins matrices one above the other
+//
+// returns: a pointer to the new Matrix, or an *ErrShape
+//          if the matrices don't all have the same number
+//          of columns
+func VStack(ms ...*Matrix) (*Matrix, error) {
+	blocks := make([][]*Matrix, len(ms))
+	for i, m := range ms {
+		blocks[i] = []*Matrix{m}
+	}
+
+	return block("vstack", blocks)
+}
+
+// brief: Builds a block diagonal Matrix
+//
+// details: The matrices go along the diagonal in order and
+//          every entry off their blocks is zero. They needn't
+//          be square.
+//
+// returns: a pointer to the new Matrix
+func BlockDiag(ms ...*Matrix) *Matrix {
+	rows, cols := 0, 0
+	for _, m := range ms {
+		rows += m.numRows
+		cols += m.numCols
+	}
+
+	result := BlankMatrix(rows, cols)
+	i0, j0 := 0, 0
+	for _, m := range ms {
+		result.paste(i0, j0, m)
+		i0 += m.numRows
+		j0 += m.numCols
+	}
+
+	return result
+}
+
+// brief: Builds a Matrix out of a grid of blocks
+//
+// details: blocks[i][j] is placed in block row i and block
+//          column j, so the blocks of a row need the same
+//          number of rows and those of a column the same
+//          number of columns, e.g. for a saddle point system
+//
+//	Block([][]*Matrix{{A, B.T()}, {B, C}})
+//
+// returns: a pointer to the new Matrix, an error if the
+//          block rows have different lengths, or an *ErrShape
+//          naming the first block that doesn't fit
+func Block(blocks [][]*Matrix) (*Matrix, error) {
+	return block("block", blocks)
+}
+
+// brief: Calculates the Kronecker product of two matrices
+//
+// details: The result is made of a_ij * b blocks, so for
+//          an m x n a and p x q b it is mp x nq
+//
+// returns: a pointer to the product
+func Kronecker(a, b *Matrix) *Matrix {
+	result := BlankMatrix(a.numRows*b.numRows, a.numCols*b.numCols)
+	for i := 0; i < a.numRows; i++ {
+		for j, aij := range a.rowView(i) {
+			// Zero entries of a are multiplied out too,
+			// so an Inf or NaN in b still gives NaN
+			for k := 0; k < b.numRows; k++ {
+				row := result.rowView(i*b.numRows + k)[j*b.numCols:]
+				for l, v := range b.rowView(k) {
+					row[l] = aij * v
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+///////////////////////////////
+//         HELPER            //
+//         FUNCTIONS         //
+///////////////////////////////
+
+// brief: Validates a grid of blocks and assembles it
+//
+// inputs: op, the operation named in errors
+func block(op string, blocks [][]*Matrix) (*Matrix, error) {
+	if len(blocks) == 0 {
+		return BlankMatrix(0, 0), nil
+	}
+	first := blocks[0]
+	for i, row := range blocks {
+		if len(row) != len(first) {
+			return nil, fmt.Errorf("Block row %d has %d blocks, expected %d", i, len(row), len(first))
+		}
+	}
+	if len(first) == 0 {
+		return BlankMatrix(0, 0), nil
+	}
+
+	// Block row heights come from the first column of
+	// blocks, block column widths from the first row
+	rows, cols := 0, 0
+	for i, row := range blocks {
+		rows += row[0].numRows
+		for j, b := range row {
+			if b.numRows != row[0].numRows || b.numCols != first[j].numCols {
+				return nil, errShape(fmt.Sprintf("%s at block (%d, %d)", op, i, j),
+					row[0].numRows, first[j].numCols, b.numRows, b.numCols)
+			}
+		}
+	}
+	for _, b := range first {
+		cols += b.numCols
+	}
+
+	result := BlankMatrix(rows, cols)
+	i0 := 0
+	for _, row := range blocks {
+		j0 := 0
+		for _, b := range row {
+			result.paste(i0, j0, b)
+			j0 += b.numCols
+		}
+		i0 += row[0].numRows
+	}
+
+	return result, nil
+}
+
+// brief: Copies src into m with its top left corner at (i0, j0)
+//
+// note: src has to fit, it isn't checked
+func (m *Matrix) paste(i0, j0 int, src *Matrix) {
+	for i := 0; i < src.numRows; i++ {
+		copy(m.rowView(i0 + i)[j0:], src.rowView(i))
+	}
+}
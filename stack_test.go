@@ -0,0 +1,114 @@
+package golinal
+
+import (
+    "github.com/stretchr/testify/suite";
+    "errors";
+    "math";
+    "testing"
+)
+
+//*************************************
+// Stacking and Block Test Suite
+//*************************************
+
+type StackTestSuite struct {
+    suite.Suite
+
+    A, B *Matrix
+}
+
+func (suite *StackTestSuite) SetupTest() {
+    suite.A = MustMatrix(
+        []float64{1, 2},
+        []float64{3, 4},
+    )
+    suite.B = MustMatrix(
+        []float64{0, 5},
+        []float64{6, 7},
+    )
+}
+
+func (suite *StackTestSuite) TestHStack() {
+    aug, err := HStack(suite.A, MustMatrix([]float64{5}, []float64{6}))
+    suite.Equal(err, nil, "There should be no error")
+    suite.Equal(MustMatrix(
+        []float64{1, 2, 5},
+        []float64{3, 4, 6},
+    ), aug, "They should be equal")
+
+    _, err = HStack(suite.A, NonsquareMatrix2)
+    suite.True(errors.Is(err, &ErrShape{}), "Error should match ErrShape")
+    suite.Contains(err.Error(), "hstack at block (0, 1)", "The error should name the block")
+}
+
+func (suite *StackTestSuite) TestVStack() {
+    stacked, err := VStack(suite.A, NonsquareMatrix2, suite.B)
+    suite.Equal(err, nil, "There should be no error")
+    suite.Equal(5, stacked.NumRows(), "They should be equal")
+    suite.Equal([]float64{0, 5}, stacked.rowView(3), "They should be equal")
+
+    _, err = VStack(suite.A, NonsquareMatrix)
+    suite.True(errors.Is(err, &ErrShape{}), "Error should match ErrShape")
+
+    empty, err := VStack()
+    suite.Equal(err, nil, "There should be no error")
+    suite.Equal(0, empty.NumRows(), "They should be equal")
+}
+
+func (suite *StackTestSuite) TestBlockDiag() {
+    suite.Equal(MustMatrix(
+        []float64{1, 2, 0},
+        []float64{3, 4, 0},
+        []float64{0, 0, 1},
+        []float64{0, 0, -7},
+    ), BlockDiag(suite.A, NonsquareMatrix), "They should be equal")
+}
+
+func (suite *StackTestSuite) TestBlock() {
+    m, err := Block([][]*Matrix{
+        {suite.A, suite.B},
+        {NonsquareMatrix2, NonsquareMatrix2},
+    })
+    suite.Equal(err, nil, "There should be no error")
+    suite.Equal(3, m.NumRows(), "They should be equal")
+    suite.Equal(4, m.NumCols(), "They should be equal")
+    suite.Equal([]float64{3, 4, 6, 7}, m.rowView(1), "They should be equal")
+
+    _, err = Block([][]*Matrix{
+        {suite.A, suite.B},
+        {NonsquareMatrix2, NonsquareMatrix},
+    })
+    var shape *ErrShape
+    suite.True(errors.As(err, &shape), "Error should match ErrShape")
+    suite.Equal(1, shape.Rows, "The expected height should be reported")
+    suite.Equal(2, shape.Cols, "The expected width should be reported")
+    suite.Contains(err.Error(), "block at block (1, 1)", "The error should name the block")
+
+    _, err = Block([][]*Matrix{{suite.A, suite.B}, {suite.A}})
+    suite.NotEqual(err, nil, "There should be an error")
+}
+
+func (suite *StackTestSuite) TestKronecker() {
+    suite.Equal(MustMatrix(
+        []float64{0, 5, 0, 10},
+        []float64{6, 7, 12, 14},
+        []float64{0, 15, 0, 20},
+        []float64{18, 21, 24, 28},
+    ), Kronecker(suite.A, suite.B), "They should be equal")
+
+    k := Kronecker(NonsquareMatrix, NonsquareMatrix2)
+    suite.Equal(2, k.NumRows(), "They should be equal")
+    suite.Equal(2, k.NumCols(), "They should be equal")
+    suite.Equal(Identity(6), Kronecker(Identity(2), Identity(3)), "They should be equal")
+
+    // 0 * Inf and 0 * NaN are NaN
+    k = Kronecker(MustMatrix([]float64{0, 1}), MustMatrix([]float64{math.Inf(1), math.NaN()}))
+    suite.True(math.IsNaN(k.At(0, 0)), "0 * Inf should be NaN")
+    suite.True(math.IsNaN(k.At(0, 1)), "0 * NaN should be NaN")
+    suite.True(math.IsInf(k.At(0, 2), 1), "1 * Inf should be Inf")
+    suite.True(math.IsNaN(k.At(0, 3)), "1 * NaN should be NaN")
+}
+
+func TestStack(t *testing.T) {
+    suite.Run(t, new(StackTestSuite))
+}
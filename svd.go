@@ -0,0 +1,303 @@
+package golinal
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// Sweeps over all column pairs allowed before giving up
+const maxJacobiSweeps = 60
+
+// SVDKind selects how much of U and V an SVD computes
+type SVDKind int
+
+const (
+	// U is m x k and V is n x k, k = min(m, n)
+	SVDThin SVDKind = iota
+
+	// U is m x m and V is n x n
+	SVDFull
+)
+
+// SVD Struct Definition
+//
+// details: Holds the singular value decomposition A = U S V^T
+//          of an m x n Matrix A. U and V have orthonormal
+//          columns and the singular values on the diagonal
+//          of S are sorted in descending order.
+type SVD struct {
+	u, v   *Matrix
+	values []float64
+	rows   int
+	cols   int
+}
+
+// brief: Calculates the singular value decomposition of a Matrix
+//
+// details: One-sided Jacobi (Hestenes): plane rotations are
+//          applied to pairs of columns until they are all
+//          mutually orthogonal, their norms then being the
+//          singular values. Slower than Golub-Kahan but computes
+//          small singular values to high relative accuracy.
+//          A wide Matrix is handled through its transpose, and
+//          entries are scaled by a power of two so neither huge
+//          nor tiny ones over or underflow. O(sweeps * mn^2)
+//
+// inputs: kind, SVDThin or SVDFull
+//
+// returns: the decomposition, or an error if m has NaN or
+//          infinite entries or the iteration doesn't converge
+func (m *Matrix) SVD(kind SVDKind) (*SVD, error) {
+	if !m.isFinite() {
+		return nil, errors.New("Matrix has NaN or infinite entries")
+	}
+
+	// Rows of W are the columns being orthogonalized, columns
+	// of A if A is tall and of A^T if it's wide
+	tall := m.numRows >= m.numCols
+	var W *Matrix
+	if tall {
+		W = BlankMatrix(m.numCols, m.numRows)
+		for i := 0; i < m.numRows; i++ {
+			for j, v := range m.rowView(i) {
+				W.data[j*W.stride+i] = v
+			}
+		}
+	} else {
+		W = m.copy()
+	}
+	k, length := W.numRows, W.numCols
+
+	// Scale by a power of two, exactly, so the largest entry
+	// is in [0.5, 1) and the dot products below can't
+	// overflow or needlessly underflow
+	_, exp := math.Frexp(m.Norm(NormMaxAbs))
+	for i := 0; i < k; i++ {
+		scal(math.Ldexp(1, -exp), W.rowView(i))
+	}
+	Vt := Identity(k)
+
+	converged := false
+	for sweep := 0; sweep < maxJacobiSweeps && !converged; sweep++ {
+		converged = true
+		for p := 0; p < k-1; p++ {
+			for q := p + 1; q < k; q++ {
+				wp, wq := W.rowView(p), W.rowView(q)
+				alpha := dot(wp, wp)
+				beta := dot(wq, wq)
+				gamma := dot(wp, wq)
+				if gamma == 0 || math.Abs(gamma) <= eps*math.Sqrt(alpha*beta) {
+					continue
+				}
+				converged = false
+
+				// Rotation zeroing the (p, q) entry of W W^T
+				zeta := (beta - alpha) / (2 * gamma)
+				t := math.Copysign(1, zeta) / (math.Abs(zeta) + math.Hypot(1, zeta))
+				c := 1 / math.Hypot(1, t)
+				s := c * t
+
+				rotate(wp, wq, c, s)
+				rotate(Vt.rowView(p), Vt.rowView(q), c, s)
+			}
+		}
+	}
+	if !converged {
+		return nil, errors.New("SVD failed to converge")
+	}
+
+	// Singular values are the row norms, sort them descending
+	values := make([]float64, k)
+	order := make([]int, k)
+	for i := range values {
+		values[i] = nrm2(W.rowView(i))
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return values[order[a]] > values[order[b]]
+	})
+
+	// Normalized rows of W are the singular vectors on the
+	// long side, rows of Vt those on the short side
+	rank := 0
+	sorted := make([]float64, k)
+	long := BlankMatrix(length, k)
+	short := BlankMatrix(k, k)
+	for j, i := range order {
+		sorted[j] = math.Ldexp(values[i], exp)
+		if values[i] > 0 {
+			rank++
+			for r, w := range W.rowView(i) {
+				long.data[r*long.stride+j] = w / values[i]
+			}
+		}
+		for r, v := range Vt.rowView(i) {
+			short.data[r*short.stride+j] = v
+		}
+	}
+
+	// Columns for zero singular values are still zero,
+	// replace them, and add any extra columns asked for
+	longCols := k
+	if kind == SVDFull {
+		longCols = length
+	}
+	long = completeBasis(long.cols(0, rank), longCols)
+
+	f := &SVD{values: sorted, rows: m.numRows, cols: m.numCols}
+	if tall {
+		f.u, f.v = long, short
+	} else {
+		f.u, f.v = short, long
+	}
+
+	return f, nil
+}
+
+// brief: Gets the singular values
+//
+// returns: a copy of the singular values, in descending order
+func (f *SVD) Values() []float64 {
+	values := make([]float64, len(f.values))
+	copy(values, f.values)
+
+	return values
+}
+
+// brief: Gets the left singular vectors
+//
+// returns: U as a new Matrix
+func (f *SVD) U() *Matrix {
+	return f.u.copy()
+}
+
+// brief: Gets the right singular vectors
+//
+// returns: V as a new Matrix
+func (f *SVD) V() *Matrix {
+	return f.v.copy()
+}
+
+// brief: Calculates the 2-norm of the decomposed Matrix
+//
+// returns: the largest singular value
+func (f *SVD) Norm2() float64 {
+	if len(f.values) == 0 {
+		return 0
+	}
+
+	return f.values[0]
+}
+
+// brief: Calculates the 2-norm condition number
+//
+// returns: the ratio of the largest to the smallest
+//          singular value, +Inf if A is rank deficient
+func (f *SVD) Cond2() float64 {
+	k := len(f.values)
+	if k == 0 || f.values[k-1] == 0 {
+		return math.Inf(1)
+	}
+
+	return f.values[0] / f.values[k-1]
+}
+
+// brief: Calculates the numerical rank
+//
+// inputs: tol, singular values <= tol count as zero. With
+//         tol <= 0, max(m, n) * eps * s_1 is used
+//
+// returns: the number of singular values above tol
+func (f *SVD) Rank(tol float64) int {
+	tol = f.tolerance(tol)
+
+	rank := 0
+	for _, s := range f.values {
+		if s > tol {
+			rank++
+		}
+	}
+
+	return rank
+}
+
+// brief: Calculates the Moore-Penrose pseudoinverse
+//
+// inputs: tol, as in Rank()
+//
+// details: A^+ = V S^+ U^T, where S^+ inverts the singular
+//          values above tol and zeroes the rest
+//
+// returns: the n x m pseudoinverse as a new Matrix
+func (f *SVD) PseudoInverse(tol float64) *Matrix {
+	rank := f.Rank(tol)
+	pinv := BlankMatrix(f.cols, f.rows)
+
+	// Sum of the rank-one terms v_j u_j^T / s_j
+	for i := 0; i < f.cols; i++ {
+		row := pinv.rowView(i)
+		for j := 0; j < rank; j++ {
+			vij := f.v.At(i, j) / f.values[j]
+			for r := 0; r < f.rows; r++ {
+				row[r] += vij * f.u.At(r, j)
+			}
+		}
+	}
+
+	return pinv
+}
+
+// brief: Finds an orthonormal basis of the null space
+//
+// inputs: tol, as in Rank()
+//
+// returns: an n x (n - rank) Matrix whose columns span
+//          the vectors x with Ax = 0
+func (f *SVD) NullSpace(tol float64) *Matrix {
+	rank := f.Rank(tol)
+	basis := completeBasis(f.v.cols(0, rank), f.cols)
+
+	return basis.cols(rank, f.cols)
+}
+
+// brief: Resolves the default tolerance of Rank()
+func (f *SVD) tolerance(tol float64) float64 {
+	if tol > 0 {
+		return tol
+	}
+
+	return float64(max(f.rows, f.cols)) * eps * f.Norm2()
+}
+
+// brief: Extends the orthonormal columns of q to cols
+//        orthonormal columns
+//
+// details: The complement is read off the full Q of a
+//          Householder QR of q
+func completeBasis(q *Matrix, cols int) *Matrix {
+	basis := q.QR().q(cols)
+	for i := 0; i < q.numRows; i++ {
+		copy(basis.rowView(i), q.rowView(i))
+	}
+
+	return basis
+}
+
+// brief: Applies the plane rotation [c -s; s c] to x and y
+func rotate(x, y []float64, c, s float64) {
+	for i, xi := range x {
+		x[i] = c*xi - s*y[i]
+		y[i] = s*xi + c*y[i]
+	}
+}
+
+// brief: Copies columns j0 to j1-1 of m
+func (m *Matrix) cols(j0, j1 int) *Matrix {
+	c := BlankMatrix(m.numRows, j1-j0)
+	for i := 0; i < m.numRows; i++ {
+		copy(c.rowView(i), m.rowView(i)[j0:j1])
+	}
+
+	return c
+}
@@ -0,0 +1,147 @@
+package golinal
+
+import (
+    "github.com/stretchr/testify/suite";
+    "math";
+    "testing"
+)
+
+//***************************************
+// Singular Value Decomposition Test Suite
+//***************************************
+
+type SVDTestSuite struct {
+    suite.Suite
+
+    Tall,
+    Wide,
+    RankOne *Matrix
+}
+
+func (suite *SVDTestSuite) SetupTest() {
+    suite.Tall = MustMatrix(
+        []float64{3, 0},
+        []float64{4, 5},
+        []float64{0, 0})
+
+    suite.Wide = MustMatrix(
+        []float64{3, 4, 0},
+        []float64{0, 5, 0})
+
+    suite.RankOne = MustMatrix(
+        []float64{1, 2, 3},
+        []float64{2, 4, 6})
+}
+
+// brief: Checks that A = U S V^T and that U and V
+// have orthonormal columns
+func (suite *SVDTestSuite) checkFactors(A *Matrix, svd *SVD) {
+    U, V, values := svd.U(), svd.V(), svd.Values()
+
+    UtU, _ := U.T().Multiply(U)
+    VtV, _ := V.T().Multiply(V)
+    matrixInDelta(&suite.Suite, Identity(U.NumCols()), UtU, 1e-12)
+    matrixInDelta(&suite.Suite, Identity(V.NumCols()), VtV, 1e-12)
+
+    S := BlankMatrix(U.NumCols(), V.NumCols())
+    for i, s := range values {
+        S.data[i*S.stride+i] = s
+    }
+    US, _ := U.Multiply(S)
+    USVt, _ := US.Multiply(V.T())
+    matrixInDelta(&suite.Suite, A, USVt, 1e-12)
+}
+
+func (suite *SVDTestSuite) TestValues() {
+    svd, err := suite.Tall.SVD(SVDThin)
+
+    suite.Equal(nil, err, "There should be no error")
+    suite.InDeltaSlice([]float64{3 * math.Sqrt(5), math.Sqrt(5)}, svd.Values(), 1e-12)
+    suite.InDelta(3*math.Sqrt(5), svd.Norm2(), 1e-12)
+    suite.InDelta(3.0, svd.Cond2(), 1e-12)
+    suite.Equal(2, svd.Rank(0), "They should be equal")
+
+    _, err = MustMatrix([]float64{math.NaN()}).SVD(SVDThin)
+    suite.NotEqual(nil, err, "There should be an error")
+}
+
+func (suite *SVDTestSuite) TestThinAndFull() {
+    for _, A := range []*Matrix{suite.Tall, suite.Wide, suite.RankOne, RandMatrix, RandFourMatrix} {
+        r, c := A.Dims()
+        k := min(r, c)
+
+        thin, err := A.SVD(SVDThin)
+        suite.Equal(nil, err, "There should be no error")
+        suite.Equal(k, thin.U().NumCols(), "They should be equal")
+        suite.Equal(k, thin.V().NumCols(), "They should be equal")
+        suite.checkFactors(A, thin)
+
+        full, err := A.SVD(SVDFull)
+        suite.Equal(nil, err, "There should be no error")
+        suite.Equal(r, full.U().NumCols(), "They should be equal")
+        suite.Equal(c, full.V().NumCols(), "They should be equal")
+        suite.checkFactors(A, full)
+    }
+}
+
+func (suite *SVDTestSuite) TestRankDeficient() {
+    svd, _ := suite.RankOne.SVD(SVDThin)
+
+    suite.Equal(1, svd.Rank(0), "They should be equal")
+    suite.True(math.IsInf(svd.Cond2(), 1), "Condition number should be +Inf")
+
+    // The null space is orthogonal to the rows
+    N := svd.NullSpace(0)
+    suite.Equal(3, N.NumRows(), "They should be equal")
+    suite.Equal(2, N.NumCols(), "They should be equal")
+    AN, _ := suite.RankOne.Multiply(N)
+    matrixInDelta(&suite.Suite, BlankMatrix(2, 2), AN, 1e-12)
+
+    // A A^+ A = A and A^+ A A^+ = A^+
+    pinv := svd.PseudoInverse(0)
+    suite.Equal(3, pinv.NumRows(), "They should be equal")
+    suite.Equal(2, pinv.NumCols(), "They should be equal")
+    AP, _ := suite.RankOne.Multiply(pinv)
+    APA, _ := AP.Multiply(suite.RankOne)
+    matrixInDelta(&suite.Suite, suite.RankOne, APA, 1e-12)
+    PA, _ := pinv.Multiply(suite.RankOne)
+    PAP, _ := PA.Multiply(pinv)
+    matrixInDelta(&suite.Suite, pinv, PAP, 1e-12)
+}
+
+// The pseudoinverse of an invertible Matrix is its inverse
+func (suite *SVDTestSuite) TestPseudoInverse() {
+    svd, _ := RandMatrix.SVD(SVDThin)
+    inverse, _ := RandMatrix.Inverse()
+
+    matrixInDelta(&suite.Suite, inverse, svd.PseudoInverse(0), 1e-10)
+    suite.Equal(0, svd.NullSpace(0).NumCols(), "They should be equal")
+}
+
+// Huge and tiny entries whose squares over or underflow
+func (suite *SVDTestSuite) TestScaled() {
+    base := MustMatrix(
+        []float64{1, 2},
+        []float64{3, 4})
+    want, _ := base.SVD(SVDThin)
+
+    for _, scale := range []float64{1e160, 1e-170, 1e300, 1e-300} {
+        A := base.Copy()
+        A.Scale(scale)
+        svd, err := A.SVD(SVDThin)
+        suite.Equal(nil, err, "There should be no error")
+        for i, v := range want.Values() {
+            suite.InEpsilon(v*scale, svd.Values()[i], 1e-12, "They should be equal")
+        }
+        suite.True(EqualApprox(want.U(), svd.U(), 1e-12, 0), "They should be equal")
+
+        wide := MustMatrix([]float64{scale, 2 * scale, 0})
+        svd, err = wide.SVD(SVDThin)
+        suite.Equal(nil, err, "There should be no error")
+        suite.InEpsilon(math.Sqrt(5)*scale, svd.Values()[0], 1e-12, "They should be equal")
+    }
+}
+
+func TestSVD(t *testing.T) {
+    suite.Run(t, new(SVDTestSuite))
+}
@@ -0,0 +1,332 @@
+package golinal
+
+import (
+	"math"
+)
+
+// Vector Struct Definition
+//
+type Vector struct {
+	n     int
+	elems []float64
+}
+
+// brief: Parameterized constructor that takes floats
+//
+// details: Allows us to create a Vector with only
+// providing its entries, e.g. NewVector(1, 2, 3)
+// or NewVector(s...)
+//
+// note: the entries are copied, so later changes to
+// a slice passed as s... don't affect the Vector
+//
+// returns: a pointer to a Vector
+func NewVector(elems ...float64) *Vector {
+	v := BlankVector(len(elems))
+	copy(v.elems, elems)
+
+	return v
+}
+
+// brief: Constructor for a zero Vector
+//
+// returns: a pointer to a Vector of length n
+func BlankVector(n int) *Vector {
+	v := new(Vector)
+	v.n = n
+	v.elems = make([]float64, n)
+
+	return v
+}
+
+// brief: Gets the number of entries in a Vector
+//
+// returns: the length of v
+func (v *Vector) Len() int {
+	return v.n
+}
+
+// brief: Get the i'th entry of a Vector
+//
+// note: it is undefined behavior to use an invalid index with At()
+//
+// returns: v_i
+func (v *Vector) At(i int) float64 {
+	return v.elems[i]
+}
+
+// brief: Set the i'th entry of a Vector
+//
+// note: it is undefined behavior to use an invalid index with Set()
+func (v *Vector) Set(i int, x float64) {
+	v.elems[i] = x
+}
+
+// brief: Get the i'th entry of a Vector, checking the index first
+//
+// returns: v_i, or an *ErrIndexOutOfRange if i isn't inside v
+func (v *Vector) AtChecked(i int) (float64, error) {
+	if err := v.checkIndex(i); err != nil {
+		return 0, err
+	}
+
+	return v.elems[i], nil
+}
+
+// brief: Set the i'th entry of a Vector, checking the index first
+//
+// returns: an *ErrIndexOutOfRange if i isn't inside v,
+//          in which case v is unchanged
+func (v *Vector) SetChecked(i int, x float64) error {
+	if err := v.checkIndex(i); err != nil {
+		return err
+	}
+	v.elems[i] = x
+
+	return nil
+}
+
+// brief: Checks that i lies inside v
+//
+// returns: nil, or an *ErrIndexOutOfRange
+func (v *Vector) checkIndex(i int) error {
+	if i < 0 || i >= v.n {
+		return &ErrIndexOutOfRange{Row: i, Rows: v.n, Cols: 1}
+	}
+
+	return nil
+}
+
+// brief: Copies the entries of a Vector into a new slice
+//
+// returns: a slice of floats
+func (v *Vector) Slice() []float64 {
+	s := make([]float64, v.n)
+	copy(s, v.elems)
+
+	return s
+}
+
+// brief: Calculates the dot product of two vectors
+//
+// returns: v . w, or an *ErrShape if the lengths differ
+func (v *Vector) Dot(w *Vector) (float64, error) {
+	if v.n != w.n {
+		return 0, errShape("dot", v.n, 1, w.n, 1)
+	}
+
+	return dot(v.elems, w.elems), nil
+}
+
+// brief: Calculates the p-norm of a Vector
+//
+// inputs: p, usually 1, 2 or math.Inf(1)
+//
+// details: The 2-norm is scaled while accumulating
+// so it neither overflows nor underflows
+//
+// returns: ||v||_p
+func (v *Vector) Norm(p float64) float64 {
+	switch {
+	case p == 1:
+		sum := 0.0
+		for _, x := range v.elems {
+			sum += math.Abs(x)
+		}
+		return sum
+
+	case p == 2:
+		return nrm2(v.elems)
+
+	case math.IsInf(p, 1):
+		max := 0.0
+		for _, x := range v.elems {
+			if a := math.Abs(x); a > max {
+				max = a
+			}
+		}
+		return max
+	}
+
+	sum := 0.0
+	for _, x := range v.elems {
+		sum += math.Pow(math.Abs(x), p)
+	}
+
+	return math.Pow(sum, 1/p)
+}
+
+// brief: Adds a multiple of a Vector to v
+//
+// details: v = alpha*x + v
+//
+// returns: an *ErrShape if the lengths differ
+func (v *Vector) Axpy(alpha float64, x *Vector) error {
+	if v.n != x.n {
+		return errShape("axpy", v.n, 1, x.n, 1)
+	}
+	axpy(alpha, x.elems, v.elems)
+
+	return nil
+}
+
+// brief: Scales a Vector by a real number
+//
+// inputs: A float
+func (v *Vector) Scale(alpha float64) {
+	scal(alpha, v.elems)
+}
+
+// brief: Adds two vectors together, storing the sum in v
+//
+// returns: an *ErrShape if the lengths differ
+func (v *Vector) Add(w *Vector) error {
+	return v.Axpy(1, w)
+}
+
+// brief: Subtracts w from v, storing the difference in v
+//
+// returns: an *ErrShape if the lengths differ
+func (v *Vector) Sub(w *Vector) error {
+	return v.Axpy(-1, w)
+}
+
+// brief: Multiplies two vectors element-wise, storing the result in v
+//
+// returns: an *ErrShape if the lengths differ
+func (v *Vector) Mul(w *Vector) error {
+	if v.n != w.n {
+		return errShape("mul", v.n, 1, w.n, 1)
+	}
+	for i, x := range w.elems {
+		v.elems[i] *= x
+	}
+
+	return nil
+}
+
+// brief: Divides v by w element-wise, storing the result in v
+//
+// returns: an *ErrShape if the lengths differ
+func (v *Vector) Div(w *Vector) error {
+	if v.n != w.n {
+		return errShape("div", v.n, 1, w.n, 1)
+	}
+	for i, x := range w.elems {
+		v.elems[i] /= x
+	}
+
+	return nil
+}
+
+// brief: Finds the index of the largest entry
+//
+// note: the first index is returned on ties, and -1
+// for an empty Vector
+//
+// returns: the index of the max entry
+func (v *Vector) ArgMax() int {
+	index := -1
+	for i, x := range v.elems {
+		if index < 0 || x > v.elems[index] {
+			index = i
+		}
+	}
+
+	return index
+}
+
+// brief: Finds the index of the smallest entry
+//
+// note: the first index is returned on ties, and -1
+// for an empty Vector
+//
+// returns: the index of the min entry
+func (v *Vector) ArgMin() int {
+	index := -1
+	for i, x := range v.elems {
+		if index < 0 || x < v.elems[index] {
+			index = i
+		}
+	}
+
+	return index
+}
+
+// brief: Multiplies the Matrix m by the Vector v
+//
+// details: O(mn)
+//
+// returns: the product mv, or an *ErrShape if the number
+//          of columns of m isn't the length of v
+func (m *Matrix) MulVec(v *Vector) (*Vector, error) {
+	if m.numCols != v.n {
+		return nil, errShape("multiply", m.numRows, m.numCols, v.n, 1)
+	}
+
+	result := BlankVector(m.numRows)
+	for i := 0; i < m.numRows; i++ {
+		result.elems[i] = dot(m.rowView(i), v.elems)
+	}
+
+	return result, nil
+}
+
+///////////////////////////////
+//         LEVEL 1           //
+//         KERNELS           //
+///////////////////////////////
+
+// brief: x . y over the length of x
+func dot(x, y []float64) float64 {
+	sum := 0.0
+	for i, v := range x {
+		sum += v * y[i]
+	}
+
+	return sum
+}
+
+// brief: y = alpha*x + y over the length of x
+func axpy(alpha float64, x, y []float64) {
+	if alpha == 0 {
+		return
+	}
+	for i, v := range x {
+		y[i] += alpha * v
+	}
+}
+
+// brief: x = alpha*x
+func scal(alpha float64, x []float64) {
+	for i := range x {
+		x[i] *= alpha
+	}
+}
+
+// brief: exchanges x and y over the length of x
+func swap[T Scalar](x, y []T) {
+	for i, v := range x {
+		x[i], y[i] = y[i], v
+	}
+}
+
+// brief: ||x||_2 without overflow, as in the reference BLAS dnrm2
+func nrm2(x []float64) float64 {
+	scale := 0.0
+	ssq := 1.0
+	for _, v := range x {
+		if v == 0 {
+			continue
+		}
+		a := math.Abs(v)
+		if scale < a {
+			ssq = 1 + ssq*(scale/a)*(scale/a)
+			scale = a
+		} else {
+			ssq += (a / scale) * (a / scale)
+		}
+	}
+
+	return scale * math.Sqrt(ssq)
+}
@@ -0,0 +1,118 @@
+package golinal
+
+import (
+    "github.com/stretchr/testify/suite";
+    "math";
+    "testing"
+)
+
+//*******************
+// Vector Test Suite
+//*******************
+
+type VectorTestSuite struct {
+    suite.Suite
+
+    V, W, Short *Vector
+}
+
+func (suite *VectorTestSuite) SetupTest() {
+    suite.V = NewVector(3, -4, 0)
+    suite.W = NewVector(1, 2, 4)
+    suite.Short = NewVector(1, 2)
+}
+
+func (suite *VectorTestSuite) TestConstructors() {
+    s := []float64{1, 2, 3}
+    v := NewVector(s...)
+    s[0] = 10
+
+    suite.Equal(3, v.Len(), "They should be equal")
+    suite.Equal(1.0, v.At(0), "NewVector should copy its input")
+
+    blank := BlankVector(4)
+    suite.Equal(4, blank.Len(), "They should be equal")
+    suite.Equal([]float64{0, 0, 0, 0}, blank.Slice(), "They should be equal")
+}
+
+func (suite *VectorTestSuite) TestDot() {
+    d, err := suite.V.Dot(suite.W)
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal(-5.0, d, "They should be equal")
+
+    _, err = suite.V.Dot(suite.Short)
+    suite.NotEqual(nil, err, "There should be an error")
+}
+
+func (suite *VectorTestSuite) TestNorm() {
+    suite.Equal(7.0, suite.V.Norm(1), "They should be equal")
+    suite.Equal(5.0, suite.V.Norm(2), "They should be equal")
+    suite.Equal(4.0, suite.V.Norm(math.Inf(1)), "They should be equal")
+    suite.InDelta(math.Cbrt(91), suite.V.Norm(3), 1e-12)
+
+    // Squaring these entries would overflow
+    big := NewVector(3e200, 4e200)
+    suite.InEpsilon(5e200, big.Norm(2), 1e-15)
+}
+
+func (suite *VectorTestSuite) TestAxpyScale() {
+    err := suite.V.Axpy(2, suite.W)
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal([]float64{5, 0, 8}, suite.V.Slice(), "They should be equal")
+
+    suite.V.Scale(0.5)
+    suite.Equal([]float64{2.5, 0, 4}, suite.V.Slice(), "They should be equal")
+
+    err = suite.V.Axpy(1, suite.Short)
+    suite.NotEqual(nil, err, "There should be an error")
+}
+
+func (suite *VectorTestSuite) TestElementWise() {
+    suite.Equal(nil, suite.V.Add(suite.W))
+    suite.Equal([]float64{4, -2, 4}, suite.V.Slice(), "They should be equal")
+
+    suite.Equal(nil, suite.V.Sub(suite.W))
+    suite.Equal([]float64{3, -4, 0}, suite.V.Slice(), "They should be equal")
+
+    suite.Equal(nil, suite.V.Mul(suite.W))
+    suite.Equal([]float64{3, -8, 0}, suite.V.Slice(), "They should be equal")
+
+    suite.Equal(nil, suite.V.Div(suite.W))
+    suite.Equal([]float64{3, -4, 0}, suite.V.Slice(), "They should be equal")
+
+    suite.NotEqual(nil, suite.V.Add(suite.Short), "There should be an error")
+    suite.NotEqual(nil, suite.V.Sub(suite.Short), "There should be an error")
+    suite.NotEqual(nil, suite.V.Mul(suite.Short), "There should be an error")
+    suite.NotEqual(nil, suite.V.Div(suite.Short), "There should be an error")
+}
+
+func (suite *VectorTestSuite) TestArgMaxMin() {
+    suite.Equal(0, suite.V.ArgMax(), "They should be equal")
+    suite.Equal(1, suite.V.ArgMin(), "They should be equal")
+    suite.Equal(2, suite.W.ArgMax(), "They should be equal")
+    suite.Equal(-1, BlankVector(0).ArgMax(), "They should be equal")
+
+    max, index := Max([]float64{1, 7, -3, 7})
+    suite.Equal(7.0, max, "They should be equal")
+    suite.Equal(1, index, "They should be equal")
+}
+
+func (suite *VectorTestSuite) TestMulVec() {
+    m := MustMatrix([]float64{1, 2, 3}, []float64{0, -1, 1})
+
+    mv, err := m.MulVec(suite.W)
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal([]float64{17, 2}, mv.Slice(), "They should be equal")
+
+    _, err = m.MulVec(suite.Short)
+    suite.NotEqual(nil, err, "There should be an error")
+
+    lu, _ := MustMatrix([]float64{0, 1}, []float64{1, 0}).LUP()
+    x, err := lu.SolveVec(NewVector(3, 4))
+    suite.Equal(nil, err, "There should be no error")
+    suite.Equal([]float64{4, 3}, x.Slice(), "They should be equal")
+}
+
+func TestVector(t *testing.T) {
+    suite.Run(t, new(VectorTestSuite))
+}
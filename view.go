@@ -0,0 +1,104 @@
+package golinal
+
+// brief: Gets the block of rows i0..i1-1 and columns j0..j1-1
+//
+// details: The result is a view, it shares storage with m, so
+//          writes through either are seen by both. Use Copy()
+//          for an independent Matrix. Every Matrix operation
+//          accepts views, so block algorithms can work on
+//          panels of a larger Matrix in place.
+//
+// returns: a pointer to the view, or an *ErrIndexOutOfRange
+//          unless 0 <= i0 <= i1 <= rows and 0 <= j0 <= j1 <= cols
+func (m *Matrix) Slice(i0, i1, j0, j1 int) (*Matrix, error) {
+	if i0 < 0 || i1 < i0 || i1 > m.numRows || j0 < 0 || j1 < j0 || j1 > m.numCols {
+		return nil, &ErrIndexOutOfRange{Row: i1, Col: j1, Rows: m.numRows, Cols: m.numCols}
+	}
+
+	v := new(Matrix)
+	v.numRows = i1 - i0
+	v.numCols = j1 - j0
+	v.stride = m.stride
+	if v.numRows > 0 && v.numCols > 0 {
+		v.data = m.data[i0*m.stride+j0 : (i1-1)*m.stride+j1]
+	} else {
+		// An empty view holds no data, so rowView() can't
+		// step past the end of it
+		v.stride = v.numCols
+	}
+
+	return v, nil
+}
+
+// brief: Makes a deep copy of a Matrix
+//
+// details: The copy is compact, with stride equal to its
+//          number of columns, even when m is a view
+//
+// returns: a pointer to the copy
+func (m *Matrix) Copy() *Matrix {
+	return m.copy()
+}
+
+// brief: Copies row i of a Matrix into a Vector
+//
+// returns: a pointer to a Vector of length cols, or an
+//          *ErrIndexOutOfRange if i isn't a row of m
+func (m *Matrix) Row(i int) (*Vector, error) {
+	if i < 0 || i >= m.numRows {
+		return nil, &ErrIndexOutOfRange{Row: i, Rows: m.numRows, Cols: m.numCols}
+	}
+
+	return NewVector(m.rowView(i)...), nil
+}
+
+// brief: Copies column j of a Matrix into a Vector
+//
+// returns: a pointer to a Vector of length rows, or an
+//          *ErrIndexOutOfRange if j isn't a column of m
+func (m *Matrix) Col(j int) (*Vector, error) {
+	if j < 0 || j >= m.numCols {
+		return nil, &ErrIndexOutOfRange{Col: j, Rows: m.numRows, Cols: m.numCols}
+	}
+
+	v := BlankVector(m.numRows)
+	for i := range v.elems {
+		v.elems[i] = m.data[i*m.stride+j]
+	}
+
+	return v, nil
+}
+
+// brief: Overwrites row i of a Matrix with v
+//
+// returns: an *ErrIndexOutOfRange if i isn't a row of m,
+//          or an *ErrShape if v doesn't have cols entries
+func (m *Matrix) SetRow(i int, v *Vector) error {
+	if i < 0 || i >= m.numRows {
+		return &ErrIndexOutOfRange{Row: i, Rows: m.numRows, Cols: m.numCols}
+	}
+	if v.n != m.numCols {
+		return errShape("set row", 1, m.numCols, v.n, 1)
+	}
+	copy(m.rowView(i), v.elems)
+
+	return nil
+}
+
+// brief: Overwrites column j of a Matrix with v
+//
+// returns: an *ErrIndexOutOfRange if j isn't a column of m,
+//          or an *ErrShape if v doesn't have rows entries
+func (m *Matrix) SetCol(j int, v *Vector) error {
+	if j < 0 || j >= m.numCols {
+		return &ErrIndexOutOfRange{Col: j, Rows: m.numRows, Cols: m.numCols}
+	}
+	if v.n != m.numRows {
+		return errShape("set column", m.numRows, 1, v.n, 1)
+	}
+	for i, x := range v.elems {
+		m.data[i*m.stride+j] = x
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package golinal
+
+import (
+    "github.com/stretchr/testify/suite";
+    "errors";
+    "testing"
+)
+
+//*************************************
+// Views and Slicing Test Suite
+//*************************************
+
+type ViewTestSuite struct {
+    suite.Suite
+
+    A *Matrix
+}
+
+func (suite *ViewTestSuite) SetupTest() {
+    suite.A = MustMatrix(
+        []float64{1, 2, 3, 4},
+        []float64{5, 6, 7, 8},
+        []float64{9, 10, 11, 12},
+    )
+}
+
+func (suite *ViewTestSuite) TestSlice() {
+    v, err := suite.A.Slice(1, 3, 1, 3)
+    suite.Equal(err, nil, "There should be no error")
+    suite.Equal(MustMatrix(
+        []float64{6, 7},
+        []float64{10, 11},
+    ).rows(), v.rows(), "They should be equal")
+
+    // Writes go through in both directions
+    v.Set(0, 1, -7)
+    suite.Equal(-7.0, suite.A.At(1, 2), "The write should reach the parent")
+    suite.A.Set(2, 1, -10)
+    suite.Equal(-10.0, v.At(1, 0), "The write should reach the view")
+
+    // A view of a view
+    w, err := v.Slice(1, 2, 0, 2)
+    suite.Equal(err, nil, "There should be no error")
+    w.Scale(0)
+    suite.Equal([]float64{9, 0, 0, 12}, suite.A.rowView(2), "Only the view should be zeroed")
+
+    empty, err := suite.A.Slice(3, 3, 0, 4)
+    suite.Equal(err, nil, "There should be no error")
+    suite.Equal(0, empty.NumRows(), "They should be equal")
+
+    _, err = suite.A.Slice(0, 4, 0, 1)
+    suite.True(errors.Is(err, &ErrIndexOutOfRange{}), "Error should match ErrIndexOutOfRange")
+    _, err = suite.A.Slice(2, 1, 0, 1)
+    suite.True(errors.Is(err, &ErrIndexOutOfRange{}), "Error should match ErrIndexOutOfRange")
+}
+
+// Views with no rows or no columns are valid input
+func (suite *ViewTestSuite) TestEmptySlice() {
+    for _, dims := range [][4]int{{0, 3, 1, 1}, {1, 1, 0, 4}, {2, 2, 4, 4}} {
+        v, err := suite.A.Slice(dims[0], dims[1], dims[2], dims[3])
+        suite.Equal(err, nil, "There should be no error")
+        rows, cols := dims[1]-dims[0], dims[3]-dims[2]
+
+        c := v.Copy()
+        r, cl := c.Dims()
+        suite.Equal(rows, r, "They should be equal")
+        suite.Equal(cols, cl, "They should be equal")
+        suite.Equal(0.0, v.Norm(NormFrobenius), "They should be equal")
+        r, cl = v.T().Dims()
+        suite.Equal(cols, r, "They should be equal")
+        suite.Equal(rows, cl, "They should be equal")
+        suite.Equal(v.Add(c), nil, "There should be no error")
+        _, err = v.MarshalJSON()
+        suite.Equal(err, nil, "There should be no error")
+    }
+}
+
+func (suite *ViewTestSuite) TestViewOperations() {
+    v, _ := suite.A.Slice(0, 2, 2, 4)
+    prod, err := v.Multiply(Identity(2))
+    suite.Equal(err, nil, "There should be no error")
+    suite.Equal(v.rows(), prod.rows(), "They should be equal")
+    suite.Equal(MustMatrix(
+        []float64{3, 7},
+        []float64{4, 8},
+    ).rows(), v.T().rows(), "They should be equal")
+
+    c := v.Copy()
+    _, stride := c.RawData()
+    suite.Equal(2, stride, "The copy should be compact")
+    c.Set(0, 0, 100)
+    suite.Equal(3.0, suite.A.At(0, 2), "The copy shouldn't share storage")
+}
+
+func (suite *ViewTestSuite) TestRowCol() {
+    row, err := suite.A.Row(1)
+    suite.Equal(err, nil, "There should be no error")
+    suite.Equal([]float64{5, 6, 7, 8}, row.Slice(), "They should be equal")
+
+    col, err := suite.A.Col(2)
+    suite.Equal(err, nil, "There should be no error")
+    suite.Equal([]float64{3, 7, 11}, col.Slice(), "They should be equal")
+
+    v, _ := suite.A.Slice(1, 3, 1, 3)
+    col, _ = v.Col(1)
+    suite.Equal([]float64{7, 11}, col.Slice(), "They should be equal")
+
+    _, err = suite.A.Row(3)
+    suite.True(errors.Is(err, &ErrIndexOutOfRange{}), "Error should match ErrIndexOutOfRange")
+    _, err = suite.A.Col(-1)
+    suite.True(errors.Is(err, &ErrIndexOutOfRange{}), "Error should match ErrIndexOutOfRange")
+}
+
+func (suite *ViewTestSuite) TestSetRowCol() {
+    suite.Equal(suite.A.SetRow(0, NewVector(-1, -2, -3, -4)), nil, "There should be no error")
+    suite.Equal([]float64{-1, -2, -3, -4}, suite.A.rowView(0), "They should be equal")
+
+    v, _ := suite.A.Slice(1, 3, 0, 2)
+    suite.Equal(v.SetCol(1, NewVector(60, 100)), nil, "There should be no error")
+    suite.Equal(60.0, suite.A.At(1, 1), "The write should reach the parent")
+    suite.Equal(100.0, suite.A.At(2, 1), "The write should reach the parent")
+    suite.Equal(11.0, suite.A.At(2, 2), "Outside the view should be unchanged")
+
+    err := suite.A.SetRow(0, NewVector(1, 2))
+    suite.True(errors.Is(err, &ErrShape{}), "Error should match ErrShape")
+    err = suite.A.SetCol(0, NewVector(1, 2))
+    suite.True(errors.Is(err, &ErrShape{}), "Error should match ErrShape")
+    err = suite.A.SetCol(4, NewVector(1, 2, 3))
+    suite.True(errors.Is(err, &ErrIndexOutOfRange{}), "Error should match ErrIndexOutOfRange")
+}
+
+func TestView(t *testing.T) {
+    suite.Run(t, new(ViewTestSuite))
+}